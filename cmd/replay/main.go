@@ -0,0 +1,50 @@
+package main
+
+import (
+	"Kinopoisk-Parser/config"
+	"Kinopoisk-Parser/internal/domain"
+	neo4jFailedRepo "Kinopoisk-Parser/internal/failed/repository/neo4j"
+	postgresqlFailedRepo "Kinopoisk-Parser/internal/failed/repository/postgresql"
+	failedUsecase "Kinopoisk-Parser/internal/failed/usecase"
+	neo4jFrontierRepo "Kinopoisk-Parser/internal/frontier/repository/neo4j"
+	postgresqlFrontierRepo "Kinopoisk-Parser/internal/frontier/repository/postgresql"
+	"context"
+	"github.com/BurntSushi/toml"
+	"github.com/sirupsen/logrus"
+	"os"
+	"time"
+)
+
+var configPath = os.Getenv("CONFIG_FILE")
+
+func main() {
+	appConfig := config.CreateConfig()
+	_, err := toml.DecodeFile(configPath, &appConfig)
+	if err != nil {
+		logrus.Fatal(err)
+	}
+
+	var (
+		failedRepo   domain.FailedRepository
+		frontierRepo domain.FrontierRepository
+	)
+
+	if appConfig.DbParams.Scheme == "neo4j" {
+		db := domain.InitNeo4jConnectionByParams(appConfig.DbParams)
+		failedRepo = neo4jFailedRepo.New(db)
+		frontierRepo = neo4jFrontierRepo.New(db)
+	} else {
+		db := domain.InitPgConnectionByParams(appConfig.DbParams)
+		failedRepo = postgresqlFailedRepo.New(db)
+		frontierRepo = postgresqlFrontierRepo.New(db)
+	}
+
+	usecase := failedUsecase.NewFailedUsecase(failedRepo, frontierRepo, 5*time.Second)
+
+	replayed, err := usecase.Replay(context.Background())
+	if err != nil {
+		logrus.Fatal(err)
+	}
+
+	logrus.Infof("Replayed %d failed items into the frontier", replayed)
+}
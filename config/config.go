@@ -7,7 +7,17 @@ type ServerConfig struct {
 	TimeForSleep uint64                   `toml:"time_for_sleep"`
 	MovieURL     string                   `toml:"movie_url"`
 	PersonURL    string                   `toml:"person_url"`
+	SeasonURL    string                   `toml:"season_url"`
+	ReviewURL    string                   `toml:"review_url"`
+	StudioURL    string                   `toml:"studio_url"`
 	Token        string                   `toml:"token"`
+	Crawler      CrawlerParams            `toml:"crawler"`
+	Tokens       []TokenParams            `toml:"tokens"`
+}
+
+type TokenParams struct {
+	Key        string `toml:"key"`
+	DailyQuota uint64 `toml:"daily_quota"`
 }
 
 type DatabaseConnectionParams struct {
@@ -19,6 +29,54 @@ type DatabaseConnectionParams struct {
 	Password string `toml:"password"`
 }
 
+type CrawlerParams struct {
+	Source            string       `toml:"source"`
+	FixtureDir        string       `toml:"fixture_dir"`
+	ArchiveMode       string       `toml:"archive_mode"`
+	ArchiveDir        string       `toml:"archive_dir"`
+	Workers           uint64       `toml:"workers"`
+	RequestsPerSecond float64      `toml:"requests_per_second"`
+	Burst             uint64       `toml:"burst"`
+	MaxRunSeconds     uint64       `toml:"max_run_seconds"`
+	DailyRequestLimit uint64       `toml:"daily_request_limit"`
+	MaxAttempts       uint64       `toml:"max_attempts"`
+	BackoffBaseMs     uint64       `toml:"backoff_base_ms"`
+	BackoffMaxMs      uint64       `toml:"backoff_max_ms"`
+	Mode              string       `toml:"mode"`
+	Strategy          string       `toml:"strategy"`
+	SeedFile          string       `toml:"seed_file"`
+	PageLimit         uint64       `toml:"page_limit"`
+	RefreshAfterHours uint64       `toml:"refresh_after_hours"`
+	FetchSeasons      bool         `toml:"fetch_seasons"`
+	FetchAwards       bool         `toml:"fetch_awards"`
+	FetchReviews      bool         `toml:"fetch_reviews"`
+	FetchStudios      bool         `toml:"fetch_studios"`
+	PageFilter        FilterParams `toml:"page_filter"`
+	Scope             ScopeParams  `toml:"scope"`
+}
+
+type ScopeParams struct {
+	Store  FilterParams `toml:"store"`
+	Expand FilterParams `toml:"expand"`
+}
+
+type FilterParams struct {
+	YearFrom  uint64   `toml:"year_from"`
+	YearTo    uint64   `toml:"year_to"`
+	Types     []string `toml:"types"`
+	MinRating float64  `toml:"min_rating"`
+	MinLength uint64   `toml:"min_length"`
+	Countries []string `toml:"countries"`
+}
+
 func CreateConfig() *ServerConfig {
 	return &ServerConfig{}
 }
+
+func (c *ServerConfig) APITokens() []TokenParams {
+	if len(c.Tokens) > 0 {
+		return c.Tokens
+	}
+
+	return []TokenParams{{Key: c.Token}}
+}
@@ -23,5 +23,5 @@ create table professions (
     movie_id bigint not null,
     person_id bigint not null,
     movie_role bigint not null,
-    primary key (id),
+    primary key (id)
 );
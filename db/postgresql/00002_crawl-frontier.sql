@@ -0,0 +1,12 @@
+create table crawl_frontier (
+    id bigserial not null,
+    item_kind bigint not null,
+    item_id bigint not null,
+    primary key (id)
+);
+
+create table crawl_state (
+    state_key text not null,
+    state_value bigint not null,
+    primary key (state_key)
+);
@@ -0,0 +1,6 @@
+create table visited (
+    item_kind bigint not null,
+    item_id bigint not null,
+    visited_at timestamp not null default now(),
+    primary key (item_kind, item_id)
+);
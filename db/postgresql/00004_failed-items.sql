@@ -0,0 +1,8 @@
+create table failed_items (
+    item_kind bigint not null,
+    item_id bigint not null,
+    error text not null,
+    attempts bigint not null default 1,
+    failed_at timestamp not null default now(),
+    primary key (item_kind, item_id)
+);
@@ -0,0 +1,11 @@
+alter table crawl_frontier add column priority double precision not null default 0;
+
+-- the queue could hold an item several times before the constraint; every
+-- priority is still 0 here, so the earliest copy (lowest id) is kept
+delete from crawl_frontier a
+    using crawl_frontier b
+where a.item_kind = b.item_kind
+  and a.item_id = b.item_id
+  and a.id > b.id;
+
+alter table crawl_frontier add constraint crawl_frontier_item_unique unique (item_kind, item_id);
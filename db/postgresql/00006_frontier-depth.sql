@@ -0,0 +1,3 @@
+alter table crawl_frontier add column depth bigint not null default 0;
+
+alter table crawl_frontier add column max_depth bigint not null default 0;
@@ -0,0 +1,5 @@
+alter table movie add column fetched_at timestamptz not null default to_timestamp(0);
+alter table person add column fetched_at timestamptz not null default to_timestamp(0);
+
+create index movie_fetched_at_idx on movie (fetched_at);
+create index person_fetched_at_idx on person (fetched_at);
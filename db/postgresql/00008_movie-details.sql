@@ -0,0 +1,16 @@
+alter table movie add column alternative_name text not null default '';
+alter table movie add column en_name text not null default '';
+alter table movie add column movie_type text not null default '';
+alter table movie add column status text not null default '';
+alter table movie add column description text not null default '';
+alter table movie add column short_description text not null default '';
+alter table movie add column age_rating bigint not null default 0;
+alter table movie add column rating_kp float not null default 0;
+alter table movie add column rating_tmdb float not null default 0;
+alter table movie add column rating_film_critics float not null default 0;
+alter table movie add column rating_russian_film_critics float not null default 0;
+alter table movie add column rating_await float not null default 0;
+alter table movie add column budget_currency text not null default '';
+alter table movie add column gross_currency text not null default '';
+alter table movie add column genres text[] not null default '{}';
+alter table movie add column countries text[] not null default '{}';
@@ -0,0 +1,11 @@
+update person set age = 0 where age is null;
+update person set height = 0 where height is null;
+
+alter table person alter column age set default 0;
+alter table person alter column height set default 0;
+alter table person add column en_name text not null default '';
+alter table person add column photo text not null default '';
+alter table person add column sex text not null default '';
+alter table person add column birthday timestamptz;
+alter table person add column death timestamptz;
+alter table person add column birthplace text not null default '';
@@ -0,0 +1,2 @@
+alter table professions add column character_name text not null default '';
+alter table professions add column position bigint not null default 0;
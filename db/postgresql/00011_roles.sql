@@ -0,0 +1,22 @@
+create table roles (
+    id bigint not null,
+    name text not null,
+    en_name text not null unique,
+    relation_type text not null,
+    primary key (id)
+);
+
+insert into roles (id, name, en_name, relation_type) values
+    (1, 'режиссеры', 'director', 'DIRECTED'),
+    (2, 'продюсеры', 'producer', 'PRODUCED'),
+    (3, 'сценаристы', 'writer', 'WROTE'),
+    (4, 'актеры', 'actor', 'ACTED_IN'),
+    (5, 'композиторы', 'composer', 'COMPOSED'),
+    (6, 'операторы', 'operator', 'SHOT'),
+    (7, 'монтажеры', 'editor', 'EDITED'),
+    (8, 'художники', 'designer', 'DESIGNED'),
+    (9, 'актеры дубляжа', 'voice_actor', 'VOICED');
+
+delete from professions where movie_role not in (select id from roles);
+
+alter table professions add constraint professions_movie_role_fkey foreign key (movie_role) references roles (id);
@@ -0,0 +1,24 @@
+alter table movie add column is_series boolean not null default false;
+
+create table seasons (
+    movie_id bigint not null,
+    number bigint not null,
+    name text not null default '',
+    en_name text not null default '',
+    episodes_count bigint not null default 0,
+    air_date timestamptz,
+    description text not null default '',
+    primary key (movie_id, number)
+);
+
+create table episodes (
+    movie_id bigint not null,
+    season_number bigint not null,
+    number bigint not null,
+    name text not null default '',
+    en_name text not null default '',
+    air_date timestamptz,
+    description text not null default '',
+    primary key (movie_id, season_number, number),
+    foreign key (movie_id, season_number) references seasons (movie_id, number) on delete cascade
+);
@@ -0,0 +1,11 @@
+create table movie_relations (
+    movie_id bigint not null,
+    related_id bigint not null,
+    relation_type text not null,
+    name text not null default '',
+    movie_year bigint not null default 0,
+    position bigint not null default 0,
+    primary key (movie_id, related_id, relation_type)
+);
+
+create index movie_relations_related_id_idx on movie_relations (related_id);
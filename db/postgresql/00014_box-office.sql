@@ -0,0 +1,13 @@
+alter table movie add column gross_usa bigint not null default 0;
+alter table movie add column gross_usa_currency text not null default '';
+alter table movie add column gross_rus bigint not null default 0;
+alter table movie add column gross_rus_currency text not null default '';
+
+create table exchange_rates (
+    currency text not null,
+    rate float not null,
+    updated_at timestamptz not null default now(),
+    primary key (currency)
+);
+
+insert into exchange_rates (currency, rate) values ('$', 1);
@@ -0,0 +1,14 @@
+create table awards (
+    id bigserial not null,
+    movie_id bigint not null default 0,
+    person_id bigint not null default 0,
+    award text not null default '',
+    nomination text not null default '',
+    award_year bigint not null default 0,
+    winner boolean not null default false,
+    primary key (id)
+);
+
+create index awards_movie_id on awards (movie_id);
+create index awards_person_id on awards (person_id);
+create index awards_award on awards (award);
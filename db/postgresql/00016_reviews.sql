@@ -0,0 +1,16 @@
+alter table movie add column review_count bigint not null default 0;
+alter table movie add column review_positive bigint not null default 0;
+alter table movie add column review_percentage text not null default '';
+
+create table reviews (
+    id bigint not null,
+    movie_id bigint not null,
+    title text not null default '',
+    review text not null default '',
+    review_type text not null default '',
+    author text not null default '',
+    review_date timestamptz,
+    primary key (id)
+);
+
+create index reviews_movie_id on reviews (movie_id, review_date);
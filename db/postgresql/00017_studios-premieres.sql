@@ -0,0 +1,25 @@
+create table studios (
+    id bigint not null,
+    title text not null default '',
+    studio_type text not null default '',
+    sub_type text not null default '',
+    primary key (id)
+);
+
+create table movie_studios (
+    movie_id bigint not null,
+    studio_id bigint not null,
+    primary key (movie_id, studio_id)
+);
+
+create index movie_studios_studio_id_idx on movie_studios (studio_id);
+
+create table premieres (
+    movie_id bigint not null,
+    country text not null,
+    premiere_date timestamptz not null,
+    primary key (movie_id, country)
+);
+
+create index premieres_country_date_idx on premieres (country, premiere_date);
+create index premieres_date_idx on premieres (premiere_date);
@@ -0,0 +1,3 @@
+alter table crawl_frontier add column claimed_at timestamptz;
+
+create index crawl_frontier_claimed_at on crawl_frontier (claimed_at);
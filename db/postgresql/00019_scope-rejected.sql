@@ -0,0 +1,3 @@
+alter table visited add column rejected boolean not null default false;
+
+create index visited_rejected on visited (item_kind, item_id) where rejected;
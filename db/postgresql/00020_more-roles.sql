@@ -0,0 +1,4 @@
+insert into roles (id, name, en_name, relation_type) values
+    (10, 'переводчики', 'translator', 'TRANSLATED'),
+    (11, 'режиссеры дубляжа', 'voice_director', 'DIRECTED_DUBBING')
+on conflict do nothing;
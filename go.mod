@@ -9,6 +9,7 @@ require (
 	github.com/lib/pq v1.10.9
 	github.com/neo4j/neo4j-go-driver/v5 v5.16.0
 	github.com/sirupsen/logrus v1.9.3
+	golang.org/x/time v0.5.0
 )
 
 require (
@@ -35,6 +36,5 @@ require (
 	golang.org/x/net v0.20.0 // indirect
 	golang.org/x/sys v0.16.0 // indirect
 	golang.org/x/text v0.14.0 // indirect
-	golang.org/x/time v0.5.0 // indirect
 	google.golang.org/protobuf v1.32.0 // indirect
 )
@@ -0,0 +1,85 @@
+package http
+
+import (
+	"Kinopoisk-Parser/internal/delivery/query"
+	"Kinopoisk-Parser/internal/domain"
+	"context"
+	"encoding/json"
+	"github.com/gorilla/mux"
+	"github.com/sirupsen/logrus"
+	"net/http"
+	"strconv"
+)
+
+type AwardHandler struct {
+	AUsecase domain.AwardUsecase
+}
+
+func NewAwardHandler(usecase domain.AwardUsecase) AwardHandler {
+	return AwardHandler{AUsecase: usecase}
+}
+
+func (h *AwardHandler) GetMovieAwards(w http.ResponseWriter, r *http.Request) {
+	movieID, err := strconv.ParseUint(mux.Vars(r)["id"], 10, 64)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		logrus.Error("Bad request: movie id")
+		return
+	}
+
+	awards, err := h.AUsecase.GetMovieAwards(context.Background(), movieID, awardFilter(r))
+	writeAwards(w, awards, err)
+}
+
+func (h *AwardHandler) GetPersonAwards(w http.ResponseWriter, r *http.Request) {
+	personID, err := strconv.ParseUint(mux.Vars(r)["id"], 10, 64)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		logrus.Error("Bad request: person id")
+		return
+	}
+
+	awards, err := h.AUsecase.GetPersonAwards(context.Background(), personID, awardFilter(r))
+	writeAwards(w, awards, err)
+}
+
+func (h *AwardHandler) Find(w http.ResponseWriter, r *http.Request) {
+	limit, offset := query.Page(r)
+
+	filter := awardFilter(r)
+	filter.PersonID = query.Uint(r, "person_id", 0)
+
+	awards, err := h.AUsecase.Find(context.Background(), filter, limit, offset)
+	writeAwards(w, awards, err)
+}
+
+func writeAwards(w http.ResponseWriter, awards []domain.Award, err error) {
+	switch err {
+	case domain.InvalidAwardFilter:
+		w.WriteHeader(http.StatusBadRequest)
+		logrus.Errorf("Bad request: %v", err)
+		return
+	case nil:
+
+	default:
+		w.WriteHeader(http.StatusInternalServerError)
+		logrus.Errorf("Error while get awards: %v", err)
+		return
+	}
+
+	awardsRaw, err := json.Marshal(awards)
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		logrus.Errorf("Error while marshal: %v", err)
+		return
+	}
+
+	w.Write(awardsRaw)
+}
+
+func awardFilter(r *http.Request) domain.AwardFilter {
+	return domain.AwardFilter{
+		Award:   r.URL.Query().Get("award"),
+		Outcome: r.URL.Query().Get("outcome"),
+	}
+}
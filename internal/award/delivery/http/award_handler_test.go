@@ -0,0 +1,109 @@
+package http
+
+import (
+	"Kinopoisk-Parser/internal/domain"
+	"context"
+	"encoding/json"
+	"errors"
+	"github.com/gorilla/mux"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+type fakeAwards struct {
+	domain.AwardUsecase
+	id            uint64
+	filter        domain.AwardFilter
+	limit, offset uint64
+	err           error
+}
+
+func (f *fakeAwards) GetMovieAwards(ctx context.Context, movieID uint64, filter domain.AwardFilter) ([]domain.Award, error) {
+	f.id, f.filter = movieID, filter
+
+	return []domain.Award{{MovieID: movieID, Award: "Оскар", Winner: true}}, f.err
+}
+
+func (f *fakeAwards) GetPersonAwards(ctx context.Context, personID uint64, filter domain.AwardFilter) ([]domain.Award, error) {
+	f.id, f.filter = personID, filter
+
+	return []domain.Award{{MovieID: 301, PersonID: personID, Award: "Сатурн"}}, f.err
+}
+
+func (f *fakeAwards) Find(ctx context.Context, filter domain.AwardFilter, limit, offset uint64) ([]domain.Award, error) {
+	f.filter, f.limit, f.offset = filter, limit, offset
+
+	return []domain.Award{}, f.err
+}
+
+func serve(usecase domain.AwardUsecase, target string) *httptest.ResponseRecorder {
+	handler := NewAwardHandler(usecase)
+
+	r := mux.NewRouter()
+	r.HandleFunc("/movies/{id:[0-9]+}/awards", handler.GetMovieAwards).Methods("GET")
+	r.HandleFunc("/persons/{id:[0-9]+}/awards", handler.GetPersonAwards).Methods("GET")
+	r.HandleFunc("/awards", handler.Find).Methods("GET")
+
+	recorder := httptest.NewRecorder()
+	r.ServeHTTP(recorder, httptest.NewRequest("GET", target, nil))
+
+	return recorder
+}
+
+func TestGetMovieAwards(t *testing.T) {
+	usecase := &fakeAwards{}
+
+	recorder := serve(usecase, "/movies/301/awards?award=%D0%9E%D1%81%D0%BA%D0%B0%D1%80&outcome=won")
+	if recorder.Code != http.StatusOK {
+		t.Fatalf("status = %d", recorder.Code)
+	}
+	if usecase.id != 301 || usecase.filter.Award != "Оскар" || usecase.filter.Outcome != domain.AwardWon {
+		t.Errorf("id = %d, filter = %+v", usecase.id, usecase.filter)
+	}
+
+	var awards []domain.Award
+	if err := json.Unmarshal(recorder.Body.Bytes(), &awards); err != nil {
+		t.Fatalf("unmarshal: %v", err)
+	}
+	if len(awards) != 1 || !awards[0].Winner {
+		t.Errorf("awards = %+v", awards)
+	}
+}
+
+func TestGetPersonAwards(t *testing.T) {
+	usecase := &fakeAwards{}
+
+	recorder := serve(usecase, "/persons/7836/awards")
+	if recorder.Code != http.StatusOK {
+		t.Fatalf("status = %d", recorder.Code)
+	}
+	if usecase.id != 7836 || usecase.filter != (domain.AwardFilter{}) {
+		t.Errorf("id = %d, filter = %+v", usecase.id, usecase.filter)
+	}
+}
+
+func TestFindAwards(t *testing.T) {
+	usecase := &fakeAwards{}
+
+	recorder := serve(usecase, "/awards?outcome=nominated&person_id=7836&limit=5&offset=15")
+	if recorder.Code != http.StatusOK {
+		t.Fatalf("status = %d", recorder.Code)
+	}
+	if usecase.filter.PersonID != 7836 || usecase.filter.Outcome != domain.AwardNominated ||
+		usecase.limit != 5 || usecase.offset != 15 {
+		t.Errorf("filter = %+v, limit = %d, offset = %d", usecase.filter, usecase.limit, usecase.offset)
+	}
+	if recorder.Body.String() != "[]" {
+		t.Errorf("body = %s", recorder.Body.String())
+	}
+}
+
+func TestAwardsErrors(t *testing.T) {
+	if code := serve(&fakeAwards{err: domain.InvalidAwardFilter}, "/awards?outcome=lost").Code; code != http.StatusBadRequest {
+		t.Errorf("invalid filter: status = %d", code)
+	}
+	if code := serve(&fakeAwards{err: errors.New("connection refused")}, "/movies/301/awards").Code; code != http.StatusInternalServerError {
+		t.Errorf("usecase error: status = %d", code)
+	}
+}
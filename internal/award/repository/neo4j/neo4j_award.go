@@ -0,0 +1,161 @@
+package neo4jAwardRepo
+
+import (
+	"Kinopoisk-Parser/internal/domain"
+	"context"
+	"github.com/neo4j/neo4j-go-driver/v5/neo4j"
+	"github.com/sirupsen/logrus"
+)
+
+const awardFilter = "($award = '' OR a.Title = $award) AND ($winner IS NULL OR r.Winner = $winner)"
+
+type Neo4jAwardRepo struct {
+	Driver neo4j.DriverWithContext
+}
+
+func New(driver neo4j.DriverWithContext) domain.AwardRepository {
+	return &Neo4jAwardRepo{Driver: driver}
+}
+
+func (n Neo4jAwardRepo) GetByMovie(ctx context.Context, movieID uint64, filter domain.AwardFilter) ([]domain.Award, error) {
+	return n.getAwards(ctx,
+		"MATCH (m:Movie {ID: $id})-[r:NOMINATED_FOR]->(a:Award) WHERE "+awardFilter+" "+
+			"RETURN m.ID AS movieID, 0 AS personID, r, a ORDER BY r.Year DESC",
+		filterParams(filter, map[string]any{"id": movieID}))
+}
+
+func (n Neo4jAwardRepo) GetByPerson(ctx context.Context, personID uint64, filter domain.AwardFilter) ([]domain.Award, error) {
+	return n.getAwards(ctx,
+		"MATCH (p:Person {ID: $id})-[r:NOMINATED_FOR]->(a:Award) WHERE "+awardFilter+" "+
+			"RETURN r.MovieID AS movieID, p.ID AS personID, r, a ORDER BY r.Year DESC",
+		filterParams(filter, map[string]any{"id": personID}))
+}
+
+// Find returns the awards of movies, limited to the movies in which the person
+// of the filter took part when it is set.
+func (n Neo4jAwardRepo) Find(ctx context.Context, filter domain.AwardFilter, limit, offset uint64) ([]domain.Award, error) {
+	match := "MATCH (m:Movie)-[r:NOMINATED_FOR]->(a:Award)"
+	if filter.PersonID != 0 {
+		match = "MATCH (:Person {ID: $personID})-->(m:Movie)-[r:NOMINATED_FOR]->(a:Award)"
+	}
+
+	return n.getAwards(ctx,
+		match+" WHERE "+awardFilter+" "+
+			"RETURN DISTINCT m.ID AS movieID, 0 AS personID, r, a ORDER BY r.Year DESC SKIP $offset LIMIT $limit",
+		filterParams(filter, map[string]any{
+			"personID": filter.PersonID,
+			"limit":    limit,
+			"offset":   offset,
+		}))
+}
+
+func filterParams(filter domain.AwardFilter, params map[string]any) map[string]any {
+	params["award"] = filter.Award
+	params["winner"] = nil
+
+	if winner := filter.Winner(); winner != nil {
+		params["winner"] = *winner
+	}
+
+	return params
+}
+
+func (n Neo4jAwardRepo) getAwards(ctx context.Context, query string, params map[string]any) ([]domain.Award, error) {
+	result, err := neo4j.ExecuteQuery(ctx, n.Driver, query, params, neo4j.EagerResultTransformer)
+	if err != nil {
+		logrus.Error(err)
+		return nil, err
+	}
+
+	resultAwards := make([]domain.Award, 0)
+
+	for _, record := range result.Records {
+		relation, _, err := neo4j.GetRecordValue[neo4j.Relationship](record, "r")
+		if err != nil {
+			return nil, err
+		}
+
+		awardNode, _, err := neo4j.GetRecordValue[neo4j.Node](record, "a")
+		if err != nil {
+			return nil, err
+		}
+
+		award := domain.Award{}
+
+		movieID, _, _ := neo4j.GetRecordValue[int64](record, "movieID")
+		award.MovieID = uint64(movieID)
+
+		personID, _, _ := neo4j.GetRecordValue[int64](record, "personID")
+		award.PersonID = uint64(personID)
+
+		award.Award, _ = neo4j.GetProperty[string](awardNode, "Title")
+		award.Nomination, _ = neo4j.GetProperty[string](relation, "Nomination")
+		award.Winner, _ = neo4j.GetProperty[bool](relation, "Winner")
+
+		year, _ := neo4j.GetProperty[int64](relation, "Year")
+		award.Year = uint64(year)
+
+		resultAwards = append(resultAwards, award)
+	}
+
+	return resultAwards, nil
+}
+
+func (n Neo4jAwardRepo) ReplaceMovieAwards(ctx context.Context, movieID uint64, awards []domain.Award) error {
+	return n.replace(ctx, "Movie", movieID, awards)
+}
+
+func (n Neo4jAwardRepo) ReplacePersonAwards(ctx context.Context, personID uint64, awards []domain.Award) error {
+	return n.replace(ctx, "Person", personID, awards)
+}
+
+func (n Neo4jAwardRepo) replace(ctx context.Context, label string, id uint64, awards []domain.Award) error {
+	nominations := make([]any, 0, len(awards))
+	for _, award := range awards {
+		nominations = append(nominations, map[string]any{
+			"Award":      award.Award,
+			"Nomination": award.Nomination,
+			"Year":       award.Year,
+			"Winner":     award.Winner,
+			"MovieID":    award.MovieID,
+		})
+	}
+
+	params := map[string]any{
+		"id":          id,
+		"nominations": nominations,
+	}
+
+	session := n.Driver.NewSession(ctx, neo4j.SessionConfig{})
+	defer func() {
+		err := session.Close(ctx)
+		if err != nil {
+			logrus.Errorf("Repo closing error: %v", err)
+		}
+	}()
+
+	// A failed write rolls back the delete and keeps the old nominations.
+	_, err := session.ExecuteWrite(ctx, func(tx neo4j.ManagedTransaction) (any, error) {
+		result, err := tx.Run(ctx, "MATCH (:"+label+" {ID: $id})-[r:NOMINATED_FOR]->() DELETE r", params)
+		if err != nil {
+			return nil, err
+		}
+		_, err = result.Consume(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		result, err = tx.Run(ctx,
+			"MATCH (n:"+label+" {ID: $id}) UNWIND $nominations AS nomination "+
+				"MERGE (a:Award {Title: nomination.Award}) "+
+				"CREATE (n)-[:NOMINATED_FOR {Nomination: nomination.Nomination, Year: nomination.Year, "+
+				"Winner: nomination.Winner, MovieID: nomination.MovieID}]->(a)", params)
+		if err != nil {
+			return nil, err
+		}
+
+		return result.Consume(ctx)
+	})
+
+	return err
+}
@@ -0,0 +1,127 @@
+package postgresql
+
+import (
+	"Kinopoisk-Parser/internal/domain"
+	"context"
+	"database/sql"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	awardColumns = `a.id, a.movie_id, a.person_id, a.award, a.nomination, a.award_year, a.winner`
+	awardFilter  = `($1 = '' OR a.award = $1) AND ($2::boolean IS NULL OR a.winner = $2)`
+)
+
+type pgAwardRepo struct {
+	Conn *sql.DB
+}
+
+func New(conn *sql.DB) domain.AwardRepository {
+	return &pgAwardRepo{Conn: conn}
+}
+
+func (p pgAwardRepo) GetByMovie(ctx context.Context, movieID uint64, filter domain.AwardFilter) ([]domain.Award, error) {
+	query := `SELECT ` + awardColumns + ` FROM awards a WHERE ` + awardFilter + `
+			 AND a.movie_id = $3 AND a.person_id = 0 ORDER BY a.award_year DESC, a.id;`
+
+	return p.getAwards(ctx, query, filter.Award, filter.Winner(), movieID)
+}
+
+func (p pgAwardRepo) GetByPerson(ctx context.Context, personID uint64, filter domain.AwardFilter) ([]domain.Award, error) {
+	query := `SELECT ` + awardColumns + ` FROM awards a WHERE ` + awardFilter + `
+			 AND a.person_id = $3 ORDER BY a.award_year DESC, a.id;`
+
+	return p.getAwards(ctx, query, filter.Award, filter.Winner(), personID)
+}
+
+// Find returns the awards of movies, limited to the movies in which the person
+// of the filter took part when it is set.
+func (p pgAwardRepo) Find(ctx context.Context, filter domain.AwardFilter, limit, offset uint64) ([]domain.Award, error) {
+	query := `SELECT ` + awardColumns + ` FROM awards a WHERE ` + awardFilter + ` AND a.person_id = 0
+			 AND ($3 = 0 OR a.movie_id IN (SELECT movie_id FROM professions WHERE person_id = $3))
+			 ORDER BY a.award_year DESC, a.id LIMIT $4 OFFSET $5;`
+
+	return p.getAwards(ctx, query, filter.Award, filter.Winner(), filter.PersonID, limit, offset)
+}
+
+func (p pgAwardRepo) getAwards(ctx context.Context, query string, args ...any) ([]domain.Award, error) {
+	rows, err := p.Conn.QueryContext(ctx, query, args...)
+	if err != nil {
+		logrus.Errorf("Repo error: %v", err)
+		return nil, err
+	}
+
+	defer func() {
+		err := rows.Close()
+		if err != nil {
+			logrus.Errorf("Repo closing error: %v", err)
+		}
+	}()
+
+	result := make([]domain.Award, 0)
+	for rows.Next() {
+		tmpAward := domain.Award{}
+		err = rows.Scan(
+			&tmpAward.ID,
+			&tmpAward.MovieID,
+			&tmpAward.PersonID,
+			&tmpAward.Award,
+			&tmpAward.Nomination,
+			&tmpAward.Year,
+			&tmpAward.Winner)
+
+		if err != nil {
+			logrus.Errorf("Repo error: %v", err)
+			return nil, err
+		}
+
+		result = append(result, tmpAward)
+	}
+
+	return result, err
+}
+
+func (p pgAwardRepo) ReplaceMovieAwards(ctx context.Context, movieID uint64, awards []domain.Award) error {
+	query := `DELETE FROM awards WHERE movie_id = $1 AND person_id = 0;`
+
+	return p.replace(ctx, query, movieID, awards)
+}
+
+func (p pgAwardRepo) ReplacePersonAwards(ctx context.Context, personID uint64, awards []domain.Award) error {
+	query := `DELETE FROM awards WHERE person_id = $1;`
+
+	return p.replace(ctx, query, personID, awards)
+}
+
+func (p pgAwardRepo) replace(ctx context.Context, deleteQuery string, id uint64, awards []domain.Award) error {
+	tx, err := p.Conn.BeginTx(ctx, nil)
+	if err != nil {
+		logrus.Errorf("Repo error: %v", err)
+		return err
+	}
+
+	defer func() {
+		err := tx.Rollback()
+		if err != nil && err != sql.ErrTxDone {
+			logrus.Errorf("Repo rollback error: %v", err)
+		}
+	}()
+
+	_, err = tx.ExecContext(ctx, deleteQuery, id)
+	if err != nil {
+		return err
+	}
+
+	query := `INSERT into awards(movie_id, person_id, award, nomination, award_year, winner)
+			 VALUES ($1, $2, $3, $4, $5, $6);`
+
+	for _, award := range awards {
+		_, err = tx.ExecContext(ctx, query, award.MovieID, award.PersonID, award.Award, award.Nomination, award.Year,
+			award.Winner)
+		if err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
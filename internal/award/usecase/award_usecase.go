@@ -0,0 +1,112 @@
+package usecase
+
+import (
+	"Kinopoisk-Parser/internal/domain"
+	"context"
+	"fmt"
+	"github.com/sirupsen/logrus"
+	"time"
+)
+
+type awardUsecase struct {
+	awardRepo      domain.AwardRepository
+	contextTimeout time.Duration
+}
+
+func NewAwardUsecase(a domain.AwardRepository, timeout time.Duration) domain.AwardUsecase {
+	return &awardUsecase{
+		awardRepo:      a,
+		contextTimeout: timeout,
+	}
+}
+
+func validateFilter(filter domain.AwardFilter) error {
+	switch filter.Outcome {
+	case "", domain.AwardWon, domain.AwardNominated:
+		return nil
+	}
+
+	return domain.InvalidAwardFilter
+}
+
+func (u *awardUsecase) GetMovieAwards(ctx context.Context, movieID uint64, filter domain.AwardFilter) ([]domain.Award, error) {
+	err := validateFilter(filter)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, u.contextTimeout)
+	defer cancel()
+
+	awards, err := u.awardRepo.GetByMovie(ctx, movieID, filter)
+	if err != nil {
+		logrus.Errorf("Usecase: %v", err)
+		return nil, fmt.Errorf("usecase: %v", err)
+	}
+
+	return awards, nil
+}
+
+func (u *awardUsecase) GetPersonAwards(ctx context.Context, personID uint64, filter domain.AwardFilter) ([]domain.Award, error) {
+	err := validateFilter(filter)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, u.contextTimeout)
+	defer cancel()
+
+	awards, err := u.awardRepo.GetByPerson(ctx, personID, filter)
+	if err != nil {
+		logrus.Errorf("Usecase: %v", err)
+		return nil, fmt.Errorf("usecase: %v", err)
+	}
+
+	return awards, nil
+}
+
+func (u *awardUsecase) Find(ctx context.Context, filter domain.AwardFilter, limit, offset uint64) ([]domain.Award, error) {
+	err := validateFilter(filter)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, u.contextTimeout)
+	defer cancel()
+
+	awards, err := u.awardRepo.Find(ctx, filter, limit, offset)
+	if err != nil {
+		logrus.Errorf("Usecase: %v", err)
+		return nil, fmt.Errorf("usecase: %v", err)
+	}
+
+	return awards, nil
+}
+
+// SaveMovieAwards replaces the stored awards of the movie itself.
+func (u *awardUsecase) SaveMovieAwards(ctx context.Context, movieID uint64, awards []domain.Award) error {
+	ctx, cancel := context.WithTimeout(ctx, u.contextTimeout)
+	defer cancel()
+
+	err := u.awardRepo.ReplaceMovieAwards(ctx, movieID, awards)
+	if err != nil {
+		logrus.Errorf("Usecase: %v", err)
+		return fmt.Errorf("usecase: %v", err)
+	}
+
+	return nil
+}
+
+// SavePersonAwards replaces the stored awards of the person.
+func (u *awardUsecase) SavePersonAwards(ctx context.Context, personID uint64, awards []domain.Award) error {
+	ctx, cancel := context.WithTimeout(ctx, u.contextTimeout)
+	defer cancel()
+
+	err := u.awardRepo.ReplacePersonAwards(ctx, personID, awards)
+	if err != nil {
+		logrus.Errorf("Usecase: %v", err)
+		return fmt.Errorf("usecase: %v", err)
+	}
+
+	return nil
+}
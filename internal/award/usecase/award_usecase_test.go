@@ -0,0 +1,63 @@
+package usecase
+
+import (
+	"Kinopoisk-Parser/internal/domain"
+	"context"
+	"testing"
+	"time"
+)
+
+type fakeAwardRepo struct {
+	domain.AwardRepository
+	calls int
+}
+
+func (f *fakeAwardRepo) GetByMovie(ctx context.Context, movieID uint64, filter domain.AwardFilter) ([]domain.Award, error) {
+	f.calls++
+
+	return nil, nil
+}
+
+func (f *fakeAwardRepo) Find(ctx context.Context, filter domain.AwardFilter, limit, offset uint64) ([]domain.Award, error) {
+	f.calls++
+
+	return nil, nil
+}
+
+func TestInvalidOutcome(t *testing.T) {
+	repo := &fakeAwardRepo{}
+	u := NewAwardUsecase(repo, time.Second)
+
+	if _, err := u.GetMovieAwards(context.Background(), 301, domain.AwardFilter{Outcome: "lost"}); err != domain.InvalidAwardFilter {
+		t.Errorf("movie awards: err = %v", err)
+	}
+	if _, err := u.Find(context.Background(), domain.AwardFilter{Outcome: "lost"}, 10, 0); err != domain.InvalidAwardFilter {
+		t.Errorf("find: err = %v", err)
+	}
+	if repo.calls != 0 {
+		t.Errorf("repository is queried %d times with an invalid filter", repo.calls)
+	}
+}
+
+func TestValidOutcomes(t *testing.T) {
+	repo := &fakeAwardRepo{}
+	u := NewAwardUsecase(repo, time.Second)
+
+	for _, outcome := range []string{"", domain.AwardWon, domain.AwardNominated} {
+		if _, err := u.GetMovieAwards(context.Background(), 301, domain.AwardFilter{Outcome: outcome}); err != nil {
+			t.Errorf("outcome %q: err = %v", outcome, err)
+		}
+	}
+}
+
+func TestFilterWinner(t *testing.T) {
+	if winner := (domain.AwardFilter{Outcome: domain.AwardWon}).Winner(); winner == nil || !*winner {
+		t.Errorf("won: winner = %v", winner)
+	}
+	if winner := (domain.AwardFilter{Outcome: domain.AwardNominated}).Winner(); winner == nil || *winner {
+		t.Errorf("nominated: winner = %v", winner)
+	}
+	if winner := (domain.AwardFilter{}).Winner(); winner != nil {
+		t.Errorf("any outcome: winner = %v", *winner)
+	}
+}
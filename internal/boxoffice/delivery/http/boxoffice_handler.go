@@ -0,0 +1,104 @@
+package http
+
+import (
+	"Kinopoisk-Parser/internal/domain"
+	"context"
+	"encoding/json"
+	"github.com/gorilla/mux"
+	"github.com/sirupsen/logrus"
+	"io"
+	"net/http"
+	"strconv"
+)
+
+type BoxOfficeHandler struct {
+	BUsecase domain.BoxOfficeUsecase
+}
+
+func NewBoxOfficeHandler(usecase domain.BoxOfficeUsecase) BoxOfficeHandler {
+	return BoxOfficeHandler{BUsecase: usecase}
+}
+
+func (h *BoxOfficeHandler) GetBoxOffice(w http.ResponseWriter, r *http.Request) {
+	movieID, err := strconv.ParseUint(mux.Vars(r)["id"], 10, 64)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		logrus.Error("Bad request: movie id")
+		return
+	}
+
+	boxOffice, err := h.BUsecase.GetBoxOffice(context.Background(), movieID, r.URL.Query().Get("currency"))
+	switch err {
+	case domain.MovieNotFound:
+		w.WriteHeader(http.StatusNotFound)
+		logrus.Errorf("movie not found: %v", err)
+		return
+	case domain.RateNotFound:
+		w.WriteHeader(http.StatusBadRequest)
+		logrus.Errorf("Bad request: %v", err)
+		return
+	case nil:
+
+	default:
+		w.WriteHeader(http.StatusInternalServerError)
+		logrus.Errorf("Error while get box office: %v", err)
+		return
+	}
+
+	boxOfficeRaw, err := json.Marshal(boxOffice)
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		logrus.Errorf("Error while marshal: %v", err)
+		return
+	}
+
+	w.Write(boxOfficeRaw)
+}
+
+func (h *BoxOfficeHandler) GetRates(w http.ResponseWriter, r *http.Request) {
+	rates, err := h.BUsecase.GetRates(context.Background())
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		logrus.Errorf("Error while get exchange rates: %v", err)
+		return
+	}
+
+	ratesRaw, err := json.Marshal(rates)
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		logrus.Errorf("Error while marshal: %v", err)
+		return
+	}
+
+	w.Write(ratesRaw)
+}
+
+func (h *BoxOfficeHandler) SetRate(w http.ResponseWriter, r *http.Request) {
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		logrus.Error("Bad request")
+		return
+	}
+
+	var rate domain.ExchangeRate
+
+	err = json.Unmarshal(body, &rate)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		logrus.Error("Bad request while unmarshal")
+		return
+	}
+
+	err = h.BUsecase.SetRate(context.Background(), rate)
+	switch err {
+	case nil:
+		w.WriteHeader(http.StatusOK)
+	case domain.InvalidExchangeRate:
+		w.WriteHeader(http.StatusBadRequest)
+		logrus.Errorf("Error while set exchange rate: %v", err)
+	default:
+		w.WriteHeader(http.StatusInternalServerError)
+		logrus.Errorf("Error while set exchange rate: %v", err)
+	}
+}
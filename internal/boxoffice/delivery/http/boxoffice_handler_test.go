@@ -0,0 +1,110 @@
+package http
+
+import (
+	"Kinopoisk-Parser/internal/domain"
+	"context"
+	"encoding/json"
+	"github.com/gorilla/mux"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+type fakeBoxOffice struct {
+	domain.BoxOfficeUsecase
+	currency string
+	rate     domain.ExchangeRate
+	err      error
+}
+
+func (f *fakeBoxOffice) GetBoxOffice(ctx context.Context, movieID uint64, currency string) (domain.BoxOffice, error) {
+	f.currency = currency
+
+	return domain.BoxOffice{MovieID: movieID, Budget: domain.Money{Value: 100, Currency: currency}}, f.err
+}
+
+func (f *fakeBoxOffice) SetRate(ctx context.Context, rate domain.ExchangeRate) error {
+	f.rate = rate
+
+	return f.err
+}
+
+func newTestRouter(usecase domain.BoxOfficeUsecase) *mux.Router {
+	handler := NewBoxOfficeHandler(usecase)
+
+	r := mux.NewRouter()
+	r.HandleFunc("/movies/{id:[0-9]+}/box-office", handler.GetBoxOffice).Methods("GET")
+	r.HandleFunc("/exchange-rates", handler.SetRate).Methods("PUT")
+
+	return r
+}
+
+func serve(r *mux.Router, method, target, body string) *httptest.ResponseRecorder {
+	recorder := httptest.NewRecorder()
+	r.ServeHTTP(recorder, httptest.NewRequest(method, target, strings.NewReader(body)))
+
+	return recorder
+}
+
+func TestGetBoxOffice(t *testing.T) {
+	usecase := &fakeBoxOffice{}
+
+	recorder := serve(newTestRouter(usecase), "GET", "/movies/301/box-office?currency=%E2%82%BD", "")
+	if recorder.Code != http.StatusOK {
+		t.Fatalf("status = %d", recorder.Code)
+	}
+	if usecase.currency != "₽" {
+		t.Errorf("currency = %q", usecase.currency)
+	}
+
+	var boxOffice domain.BoxOffice
+	if err := json.Unmarshal(recorder.Body.Bytes(), &boxOffice); err != nil {
+		t.Fatalf("unmarshal: %v", err)
+	}
+	if boxOffice.MovieID != 301 || boxOffice.Budget.Currency != "₽" {
+		t.Errorf("box office = %+v", boxOffice)
+	}
+}
+
+func TestGetBoxOfficeErrors(t *testing.T) {
+	tests := []struct {
+		err  error
+		code int
+	}{
+		{domain.MovieNotFound, http.StatusNotFound},
+		{domain.RateNotFound, http.StatusBadRequest},
+		{domain.InvalidExchangeRate, http.StatusInternalServerError},
+	}
+
+	for _, test := range tests {
+		recorder := serve(newTestRouter(&fakeBoxOffice{err: test.err}), "GET", "/movies/301/box-office?currency=$", "")
+		if recorder.Code != test.code {
+			t.Errorf("%v: status = %d, expected %d", test.err, recorder.Code, test.code)
+		}
+	}
+}
+
+func TestSetRate(t *testing.T) {
+	usecase := &fakeBoxOffice{}
+
+	recorder := serve(newTestRouter(usecase), "PUT", "/exchange-rates", `{"currency": "₽", "rate": 0.011}`)
+	if recorder.Code != http.StatusOK {
+		t.Fatalf("status = %d", recorder.Code)
+	}
+	if usecase.rate.Currency != "₽" || usecase.rate.Rate != 0.011 {
+		t.Errorf("rate = %+v", usecase.rate)
+	}
+}
+
+func TestSetRateBadRequest(t *testing.T) {
+	r := newTestRouter(&fakeBoxOffice{})
+	if recorder := serve(r, "PUT", "/exchange-rates", `{"currency":`); recorder.Code != http.StatusBadRequest {
+		t.Errorf("malformed body: status = %d", recorder.Code)
+	}
+
+	r = newTestRouter(&fakeBoxOffice{err: domain.InvalidExchangeRate})
+	if recorder := serve(r, "PUT", "/exchange-rates", `{"currency": "₽", "rate": -1}`); recorder.Code != http.StatusBadRequest {
+		t.Errorf("invalid rate: status = %d", recorder.Code)
+	}
+}
@@ -0,0 +1,97 @@
+package neo4jExchangeRateRepo
+
+import (
+	"Kinopoisk-Parser/internal/domain"
+	"context"
+	"github.com/neo4j/neo4j-go-driver/v5/neo4j"
+	"github.com/sirupsen/logrus"
+	"time"
+)
+
+type Neo4jExchangeRateRepo struct {
+	Driver neo4j.DriverWithContext
+}
+
+func New(driver neo4j.DriverWithContext) domain.ExchangeRateRepository {
+	return &Neo4jExchangeRateRepo{Driver: driver}
+}
+
+func (n Neo4jExchangeRateRepo) GetAll(ctx context.Context) ([]domain.ExchangeRate, error) {
+	result, err := neo4j.ExecuteQuery(ctx, n.Driver,
+		"MATCH (e:ExchangeRate) return e ORDER BY e.Currency",
+		map[string]any{}, neo4j.EagerResultTransformer)
+	if err != nil {
+		logrus.Error(err)
+		return nil, err
+	}
+
+	resultRates := make([]domain.ExchangeRate, 0)
+
+	for _, record := range result.Records {
+		itemNode, _, err := neo4j.GetRecordValue[neo4j.Node](record, "e")
+		if err != nil {
+			return nil, err
+		}
+
+		resultRates = append(resultRates, rateFromNode(itemNode))
+	}
+
+	return resultRates, nil
+}
+
+func (n Neo4jExchangeRateRepo) Get(ctx context.Context, currency string) (domain.ExchangeRate, error) {
+	result, err := neo4j.ExecuteQuery(ctx, n.Driver,
+		"MATCH (e:ExchangeRate {Currency: $currency}) return e",
+		map[string]any{
+			"currency": currency,
+		}, neo4j.EagerResultTransformer)
+	if err != nil {
+		logrus.Error(err)
+		return domain.ExchangeRate{}, err
+	}
+
+	if len(result.Records) == 0 {
+		return domain.ExchangeRate{}, domain.RateNotFound
+	}
+
+	itemNode, _, err := neo4j.GetRecordValue[neo4j.Node](result.Records[0], "e")
+	if err != nil {
+		return domain.ExchangeRate{}, domain.RateNotFound
+	}
+
+	return rateFromNode(itemNode), nil
+}
+
+func rateFromNode(itemNode neo4j.Node) domain.ExchangeRate {
+	rate := domain.ExchangeRate{}
+
+	rate.Currency, _ = neo4j.GetProperty[string](itemNode, "Currency")
+	rate.Rate, _ = neo4j.GetProperty[float64](itemNode, "Rate")
+	rate.UpdatedAt, _ = neo4j.GetProperty[time.Time](itemNode, "UpdatedAt")
+
+	return rate
+}
+
+func (n Neo4jExchangeRateRepo) Set(ctx context.Context, rate *domain.ExchangeRate) error {
+	_, err := neo4j.ExecuteQuery(ctx, n.Driver,
+		"MERGE (e:ExchangeRate {Currency: $currency}) SET e.Rate = $rate, e.UpdatedAt = $updatedAt",
+		map[string]any{
+			"currency":  rate.Currency,
+			"rate":      rate.Rate,
+			"updatedAt": rate.UpdatedAt,
+		}, neo4j.EagerResultTransformer)
+
+	return err
+}
+
+func (n Neo4jExchangeRateRepo) Seed(ctx context.Context, rate *domain.ExchangeRate) error {
+	_, err := neo4j.ExecuteQuery(ctx, n.Driver,
+		"MERGE (e:ExchangeRate {Currency: $currency}) ON CREATE SET e.Rate = $rate, e.UpdatedAt = $updatedAt",
+		map[string]any{
+			"currency":  rate.Currency,
+			"rate":      rate.Rate,
+			"updatedAt": rate.UpdatedAt,
+		}, neo4j.EagerResultTransformer)
+
+	return err
+}
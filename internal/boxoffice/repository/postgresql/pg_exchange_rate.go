@@ -0,0 +1,85 @@
+package postgresql
+
+import (
+	"Kinopoisk-Parser/internal/domain"
+	"context"
+	"database/sql"
+	"github.com/sirupsen/logrus"
+)
+
+type pgExchangeRateRepo struct {
+	Conn *sql.DB
+}
+
+func New(conn *sql.DB) domain.ExchangeRateRepository {
+	return &pgExchangeRateRepo{Conn: conn}
+}
+
+func (p pgExchangeRateRepo) GetAll(ctx context.Context) ([]domain.ExchangeRate, error) {
+	query := `SELECT currency, rate, updated_at FROM exchange_rates ORDER BY currency;`
+
+	rows, err := p.Conn.QueryContext(ctx, query)
+	if err != nil {
+		logrus.Errorf("Repo error: %v", err)
+		return nil, err
+	}
+
+	defer func() {
+		err := rows.Close()
+		if err != nil {
+			logrus.Errorf("Repo closing error: %v", err)
+		}
+	}()
+
+	result := make([]domain.ExchangeRate, 0)
+	for rows.Next() {
+		tmpRate := domain.ExchangeRate{}
+		err = rows.Scan(
+			&tmpRate.Currency,
+			&tmpRate.Rate,
+			&tmpRate.UpdatedAt)
+
+		if err != nil {
+			logrus.Errorf("Repo error: %v", err)
+			return nil, err
+		}
+
+		result = append(result, tmpRate)
+	}
+
+	return result, err
+}
+
+func (p pgExchangeRateRepo) Get(ctx context.Context, currency string) (domain.ExchangeRate, error) {
+	query := `SELECT currency, rate, updated_at FROM exchange_rates WHERE currency = $1;`
+
+	rate := domain.ExchangeRate{}
+	err := p.Conn.QueryRowContext(ctx, query, currency).Scan(&rate.Currency, &rate.Rate, &rate.UpdatedAt)
+	if err == sql.ErrNoRows {
+		return domain.ExchangeRate{}, domain.RateNotFound
+	}
+	if err != nil {
+		logrus.Errorf("Repo error: %v", err)
+		return domain.ExchangeRate{}, err
+	}
+
+	return rate, nil
+}
+
+func (p pgExchangeRateRepo) Set(ctx context.Context, rate *domain.ExchangeRate) error {
+	query := `INSERT into exchange_rates(currency, rate, updated_at) VALUES ($1, $2, $3)
+			 ON CONFLICT (currency) DO UPDATE SET rate = excluded.rate, updated_at = excluded.updated_at;`
+
+	_, err := p.Conn.ExecContext(ctx, query, rate.Currency, rate.Rate, rate.UpdatedAt)
+
+	return err
+}
+
+func (p pgExchangeRateRepo) Seed(ctx context.Context, rate *domain.ExchangeRate) error {
+	query := `INSERT into exchange_rates(currency, rate, updated_at) VALUES ($1, $2, $3)
+			 ON CONFLICT (currency) DO NOTHING;`
+
+	_, err := p.Conn.ExecContext(ctx, query, rate.Currency, rate.Rate, rate.UpdatedAt)
+
+	return err
+}
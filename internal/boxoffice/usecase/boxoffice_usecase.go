@@ -0,0 +1,127 @@
+package usecase
+
+import (
+	"Kinopoisk-Parser/internal/domain"
+	"context"
+	"fmt"
+	"github.com/sirupsen/logrus"
+	"math"
+	"time"
+)
+
+type boxOfficeUsecase struct {
+	movieRepo      domain.MovieRepository
+	rateRepo       domain.ExchangeRateRepository
+	contextTimeout time.Duration
+}
+
+func NewBoxOfficeUsecase(m domain.MovieRepository, r domain.ExchangeRateRepository,
+	timeout time.Duration) domain.BoxOfficeUsecase {
+	return &boxOfficeUsecase{
+		movieRepo:      m,
+		rateRepo:       r,
+		contextTimeout: timeout,
+	}
+}
+
+// GetBoxOffice returns the budget and fees of a movie. With an empty currency
+// the values are returned as stored, otherwise all of them are converted to it.
+// A value stored without a currency can't be converted and is returned as
+// stored, with the empty currency telling it apart.
+func (u *boxOfficeUsecase) GetBoxOffice(ctx context.Context, movieID uint64, currency string) (domain.BoxOffice, error) {
+	ctx, cancel := context.WithTimeout(ctx, u.contextTimeout)
+	defer cancel()
+
+	movie, err := u.movieRepo.GetByID(ctx, movieID)
+	if err == domain.MovieNotFound {
+		return domain.BoxOffice{}, err
+	}
+	if err != nil {
+		logrus.Errorf("Usecase: %v", err)
+		return domain.BoxOffice{}, fmt.Errorf("usecase: %v", err)
+	}
+
+	result := domain.BoxOffice{
+		MovieID: movie.ID,
+		Budget:  domain.Money{Value: movie.Budget, Currency: movie.BudgetCurrency},
+		World:   domain.Money{Value: movie.Gross, Currency: movie.GrossCurrency},
+		Usa:     domain.Money{Value: movie.GrossUsa, Currency: movie.GrossUsaCurrency},
+		Russia:  domain.Money{Value: movie.GrossRus, Currency: movie.GrossRusCurrency},
+	}
+
+	if currency == "" {
+		return result, nil
+	}
+
+	for _, money := range []*domain.Money{&result.Budget, &result.World, &result.Usa, &result.Russia} {
+		err = u.convert(ctx, money, currency)
+		if err == domain.RateNotFound {
+			return domain.BoxOffice{}, err
+		}
+		if err != nil {
+			logrus.Errorf("Usecase: %v", err)
+			return domain.BoxOffice{}, fmt.Errorf("usecase: %v", err)
+		}
+	}
+
+	return result, nil
+}
+
+func (u *boxOfficeUsecase) convert(ctx context.Context, money *domain.Money, currency string) error {
+	if money.Value == 0 || money.Currency == currency {
+		money.Currency = currency
+		return nil
+	}
+
+	if money.Currency == "" {
+		logrus.Infof("Skip converting %d without a currency", money.Value)
+		return nil
+	}
+
+	from, err := u.rateRepo.Get(ctx, money.Currency)
+	if err != nil {
+		return err
+	}
+
+	to, err := u.rateRepo.Get(ctx, currency)
+	if err != nil {
+		return err
+	}
+
+	money.Value = uint64(math.Round(float64(money.Value) * from.Rate / to.Rate))
+	money.Currency = currency
+
+	return nil
+}
+
+func (u *boxOfficeUsecase) GetRates(ctx context.Context) ([]domain.ExchangeRate, error) {
+	ctx, cancel := context.WithTimeout(ctx, u.contextTimeout)
+	defer cancel()
+
+	rates, err := u.rateRepo.GetAll(ctx)
+	if err != nil {
+		logrus.Errorf("Usecase: %v", err)
+		return nil, fmt.Errorf("usecase: %v", err)
+	}
+
+	return rates, nil
+}
+
+func (u *boxOfficeUsecase) SetRate(ctx context.Context, rate domain.ExchangeRate) error {
+	if rate.Currency == "" || rate.Rate <= 0 || math.IsInf(rate.Rate, 0) {
+		return domain.InvalidExchangeRate
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, u.contextTimeout)
+	defer cancel()
+
+	rate.UpdatedAt = time.Now()
+
+	err := u.rateRepo.Set(ctx, &rate)
+	if err != nil {
+		logrus.Errorf("Usecase: %v", err)
+		return fmt.Errorf("usecase: %v", err)
+	}
+
+	return nil
+}
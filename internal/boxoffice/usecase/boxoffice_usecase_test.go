@@ -0,0 +1,152 @@
+package usecase
+
+import (
+	"Kinopoisk-Parser/internal/domain"
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+type fakeMovies struct {
+	domain.MovieRepository
+	movies map[uint64]domain.MovieBaseInfo
+}
+
+func (f fakeMovies) GetByID(ctx context.Context, id uint64) (domain.MovieBaseInfo, error) {
+	movie, ok := f.movies[id]
+	if !ok {
+		return domain.MovieBaseInfo{}, domain.MovieNotFound
+	}
+
+	return movie, nil
+}
+
+type fakeRates struct {
+	rates map[string]float64
+	set   []domain.ExchangeRate
+	err   error
+}
+
+func (f *fakeRates) GetAll(ctx context.Context) ([]domain.ExchangeRate, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+
+	result := make([]domain.ExchangeRate, 0)
+	for currency, rate := range f.rates {
+		result = append(result, domain.ExchangeRate{Currency: currency, Rate: rate})
+	}
+
+	return result, nil
+}
+
+func (f *fakeRates) Get(ctx context.Context, currency string) (domain.ExchangeRate, error) {
+	if f.err != nil {
+		return domain.ExchangeRate{}, f.err
+	}
+
+	rate, ok := f.rates[currency]
+	if !ok {
+		return domain.ExchangeRate{}, domain.RateNotFound
+	}
+
+	return domain.ExchangeRate{Currency: currency, Rate: rate}, nil
+}
+
+func (f *fakeRates) Set(ctx context.Context, rate *domain.ExchangeRate) error {
+	f.set = append(f.set, *rate)
+
+	return f.err
+}
+
+func (f *fakeRates) Seed(ctx context.Context, rate *domain.ExchangeRate) error {
+	return f.err
+}
+
+func newTestUsecase() (domain.BoxOfficeUsecase, *fakeRates) {
+	movies := fakeMovies{movies: map[uint64]domain.MovieBaseInfo{
+		301: {
+			ID:               301,
+			Budget:           63000000,
+			BudgetCurrency:   "$",
+			Gross:            463517383,
+			GrossCurrency:    "$",
+			GrossRus:         100000,
+			GrossRusCurrency: "₽",
+			GrossUsa:         1000,
+		},
+	}}
+	rates := &fakeRates{rates: map[string]float64{"$": 1, "₽": 0.01, "€": 1.25}}
+
+	return NewBoxOfficeUsecase(movies, rates, time.Second), rates
+}
+
+func TestGetBoxOfficeAsStored(t *testing.T) {
+	u, _ := newTestUsecase()
+
+	boxOffice, err := u.GetBoxOffice(context.Background(), 301, "")
+	if err != nil {
+		t.Fatalf("get box office: %v", err)
+	}
+
+	if boxOffice.Budget != (domain.Money{Value: 63000000, Currency: "$"}) ||
+		boxOffice.Russia != (domain.Money{Value: 100000, Currency: "₽"}) ||
+		boxOffice.Usa != (domain.Money{Value: 1000}) {
+		t.Errorf("box office = %+v", boxOffice)
+	}
+}
+
+func TestGetBoxOfficeConverted(t *testing.T) {
+	u, _ := newTestUsecase()
+
+	boxOffice, err := u.GetBoxOffice(context.Background(), 301, "€")
+	if err != nil {
+		t.Fatalf("get box office: %v", err)
+	}
+
+	if boxOffice.Budget != (domain.Money{Value: 50400000, Currency: "€"}) {
+		t.Errorf("budget = %+v", boxOffice.Budget)
+	}
+	if boxOffice.Russia != (domain.Money{Value: 800, Currency: "€"}) {
+		t.Errorf("russia = %+v", boxOffice.Russia)
+	}
+	if boxOffice.Usa != (domain.Money{Value: 1000}) {
+		t.Errorf("usa = %+v, an amount without a currency is not converted", boxOffice.Usa)
+	}
+}
+
+func TestGetBoxOfficeErrors(t *testing.T) {
+	u, rates := newTestUsecase()
+	ctx := context.Background()
+
+	if _, err := u.GetBoxOffice(ctx, 1, "$"); err != domain.MovieNotFound {
+		t.Errorf("missing movie: err = %v", err)
+	}
+	if _, err := u.GetBoxOffice(ctx, 301, "¥"); err != domain.RateNotFound {
+		t.Errorf("unknown currency: err = %v", err)
+	}
+
+	rates.err = errors.New("connection refused")
+	if _, err := u.GetBoxOffice(ctx, 301, "€"); err == nil || err == domain.RateNotFound {
+		t.Errorf("repository error: err = %v", err)
+	}
+}
+
+func TestSetRate(t *testing.T) {
+	u, rates := newTestUsecase()
+	ctx := context.Background()
+
+	for _, rate := range []domain.ExchangeRate{{Currency: "", Rate: 1}, {Currency: "€", Rate: 0}, {Currency: "€", Rate: -1}} {
+		if err := u.SetRate(ctx, rate); err != domain.InvalidExchangeRate {
+			t.Errorf("rate %+v: err = %v", rate, err)
+		}
+	}
+
+	if err := u.SetRate(ctx, domain.ExchangeRate{Currency: "€", Rate: 1.1}); err != nil {
+		t.Fatalf("set rate: %v", err)
+	}
+	if len(rates.set) != 1 || rates.set[0].Rate != 1.1 || rates.set[0].UpdatedAt.IsZero() {
+		t.Errorf("stored rates = %+v", rates.set)
+	}
+}
@@ -0,0 +1,96 @@
+package http
+
+import (
+	"Kinopoisk-Parser/internal/domain"
+	"context"
+	"encoding/json"
+	"github.com/sirupsen/logrus"
+	"io"
+	"net/http"
+)
+
+type CrawlHandler struct {
+	CUsecase domain.CrawlUsecase
+}
+
+func NewCrawlHandler(usecase domain.CrawlUsecase) CrawlHandler {
+	return CrawlHandler{CUsecase: usecase}
+}
+
+func (h *CrawlHandler) Start(w http.ResponseWriter, r *http.Request) {
+	h.control(w, "start", h.CUsecase.Start)
+}
+
+func (h *CrawlHandler) Pause(w http.ResponseWriter, r *http.Request) {
+	h.control(w, "pause", h.CUsecase.Pause)
+}
+
+func (h *CrawlHandler) Resume(w http.ResponseWriter, r *http.Request) {
+	h.control(w, "resume", h.CUsecase.Resume)
+}
+
+func (h *CrawlHandler) Stop(w http.ResponseWriter, r *http.Request) {
+	h.control(w, "stop", h.CUsecase.Stop)
+}
+
+func (h *CrawlHandler) SetRate(w http.ResponseWriter, r *http.Request) {
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		logrus.Error("Bad request")
+		return
+	}
+
+	var rate domain.CrawlRate
+
+	err = json.Unmarshal(body, &rate)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		logrus.Error("Bad request while unmarshal")
+		return
+	}
+
+	err = h.CUsecase.SetRate(context.Background(), rate)
+	switch err {
+	case nil:
+		w.WriteHeader(http.StatusOK)
+	case domain.InvalidCrawlRate:
+		w.WriteHeader(http.StatusBadRequest)
+		logrus.Errorf("Error while set crawl rate: %v", err)
+	default:
+		w.WriteHeader(http.StatusInternalServerError)
+		logrus.Errorf("Error while set crawl rate: %v", err)
+	}
+}
+
+func (h *CrawlHandler) GetStatus(w http.ResponseWriter, r *http.Request) {
+	status, err := h.CUsecase.GetStatus(context.Background())
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		logrus.Errorf("Error while get crawl status: %v", err)
+		return
+	}
+
+	statusRaw, err := json.Marshal(status)
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		logrus.Errorf("Error while marshal: %v", err)
+		return
+	}
+
+	w.Write(statusRaw)
+}
+
+func (h *CrawlHandler) control(w http.ResponseWriter, action string, do func(ctx context.Context) error) {
+	err := do(context.Background())
+	switch err {
+	case nil:
+		w.WriteHeader(http.StatusOK)
+	case domain.CrawlAlreadyRunning, domain.CrawlNotRunning:
+		w.WriteHeader(http.StatusConflict)
+		logrus.Errorf("Error while %s crawl: %v", action, err)
+	default:
+		w.WriteHeader(http.StatusInternalServerError)
+		logrus.Errorf("Error while %s crawl: %v", action, err)
+	}
+}
@@ -0,0 +1,118 @@
+package http
+
+import (
+	"Kinopoisk-Parser/internal/domain"
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+type fakeCrawl struct {
+	domain.CrawlUsecase
+	err    error
+	rate   domain.CrawlRate
+	status domain.CrawlStatus
+}
+
+func (f *fakeCrawl) Start(ctx context.Context) error {
+	return f.err
+}
+
+func (f *fakeCrawl) Stop(ctx context.Context) error {
+	return f.err
+}
+
+func (f *fakeCrawl) SetRate(ctx context.Context, rate domain.CrawlRate) error {
+	f.rate = rate
+
+	return f.err
+}
+
+func (f *fakeCrawl) GetStatus(ctx context.Context) (domain.CrawlStatus, error) {
+	return f.status, f.err
+}
+
+func TestControl(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		code int
+	}{
+		{"started", nil, http.StatusOK},
+		{"already running", domain.CrawlAlreadyRunning, http.StatusConflict},
+		{"not running", domain.CrawlNotRunning, http.StatusConflict},
+		{"failed", errors.New("state is unavailable"), http.StatusInternalServerError},
+	}
+
+	for _, test := range tests {
+		handler := NewCrawlHandler(&fakeCrawl{err: test.err})
+
+		recorder := httptest.NewRecorder()
+		handler.Start(recorder, httptest.NewRequest("POST", "/crawl/start", nil))
+
+		if recorder.Code != test.code {
+			t.Errorf("%s: status = %d, expected %d", test.name, recorder.Code, test.code)
+		}
+	}
+}
+
+func TestSetRate(t *testing.T) {
+	crawl := &fakeCrawl{}
+	handler := NewCrawlHandler(crawl)
+
+	recorder := httptest.NewRecorder()
+	body := strings.NewReader(`{"requests_per_second": 1.5, "burst": 2}`)
+	handler.SetRate(recorder, httptest.NewRequest("PUT", "/crawl/rate", body))
+
+	if recorder.Code != http.StatusOK {
+		t.Fatalf("status = %d", recorder.Code)
+	}
+	if crawl.rate.RequestsPerSecond != 1.5 || crawl.rate.Burst != 2 {
+		t.Errorf("rate = %+v", crawl.rate)
+	}
+}
+
+func TestSetRateBadRequest(t *testing.T) {
+	tests := []struct {
+		name string
+		body string
+		err  error
+	}{
+		{"malformed body", `{"requests_per_second":`, nil},
+		{"invalid rate", `{"requests_per_second": -1}`, domain.InvalidCrawlRate},
+	}
+
+	for _, test := range tests {
+		handler := NewCrawlHandler(&fakeCrawl{err: test.err})
+
+		recorder := httptest.NewRecorder()
+		handler.SetRate(recorder, httptest.NewRequest("PUT", "/crawl/rate", strings.NewReader(test.body)))
+
+		if recorder.Code != http.StatusBadRequest {
+			t.Errorf("%s: status = %d", test.name, recorder.Code)
+		}
+	}
+}
+
+func TestGetStatus(t *testing.T) {
+	handler := NewCrawlHandler(&fakeCrawl{status: domain.CrawlStatus{State: domain.CrawlRunning, QueueLength: 5}})
+
+	recorder := httptest.NewRecorder()
+	handler.GetStatus(recorder, httptest.NewRequest("GET", "/crawl/status", nil))
+
+	if recorder.Code != http.StatusOK {
+		t.Fatalf("status = %d", recorder.Code)
+	}
+
+	var status domain.CrawlStatus
+	if err := json.Unmarshal(recorder.Body.Bytes(), &status); err != nil {
+		t.Fatalf("unmarshal: %v", err)
+	}
+	if status.State != domain.CrawlRunning || status.QueueLength != 5 {
+		t.Errorf("crawl status = %+v", status)
+	}
+}
@@ -0,0 +1,173 @@
+package usecase
+
+import (
+	"Kinopoisk-Parser/internal/domain"
+	movieParser "Kinopoisk-Parser/internal/parser"
+	"context"
+	"fmt"
+	"github.com/sirupsen/logrus"
+	"sync"
+	"time"
+)
+
+type crawlUsecase struct {
+	parser         *movieParser.Parser
+	gate           *movieParser.RequestGate
+	frontierRepo   domain.FrontierRepository
+	contextTimeout time.Duration
+
+	mutex      sync.Mutex
+	cancel     context.CancelFunc
+	done       chan struct{}
+	startedAt  time.Time
+	stoppedAt  time.Time
+	stopReason movieParser.StopReason
+}
+
+func NewCrawlUsecase(parser *movieParser.Parser, gate *movieParser.RequestGate, f domain.FrontierRepository,
+	timeout time.Duration) domain.CrawlUsecase {
+	return &crawlUsecase{
+		parser:         parser,
+		gate:           gate,
+		frontierRepo:   f,
+		contextTimeout: timeout,
+	}
+}
+
+func (u *crawlUsecase) Start(ctx context.Context) error {
+	u.mutex.Lock()
+	defer u.mutex.Unlock()
+
+	if u.done != nil {
+		return domain.CrawlAlreadyRunning
+	}
+
+	// The crawl outlives the request that started it.
+	jobCtx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+
+	u.cancel = cancel
+	u.done = done
+	u.startedAt = time.Now()
+	u.stoppedAt = time.Time{}
+	u.stopReason = ""
+	u.parser.Resume()
+
+	go func() {
+		defer close(done)
+		defer cancel()
+
+		reason := u.parser.Parse(jobCtx)
+
+		u.mutex.Lock()
+		defer u.mutex.Unlock()
+
+		u.done = nil
+		u.cancel = nil
+		u.stoppedAt = time.Now()
+		u.stopReason = reason
+	}()
+
+	logrus.Info("Crawl started")
+
+	return nil
+}
+
+func (u *crawlUsecase) Pause(ctx context.Context) error {
+	if !u.running() {
+		return domain.CrawlNotRunning
+	}
+
+	u.parser.Pause()
+	logrus.Info("Crawl paused")
+
+	return nil
+}
+
+func (u *crawlUsecase) Resume(ctx context.Context) error {
+	if !u.running() {
+		return domain.CrawlNotRunning
+	}
+
+	u.parser.Resume()
+	logrus.Info("Crawl resumed")
+
+	return nil
+}
+
+func (u *crawlUsecase) Stop(ctx context.Context) error {
+	ctx, cancel := context.WithTimeout(ctx, u.contextTimeout)
+	defer cancel()
+
+	u.mutex.Lock()
+	done := u.done
+	if done != nil {
+		u.cancel()
+	}
+	u.mutex.Unlock()
+
+	if done == nil {
+		return domain.CrawlNotRunning
+	}
+
+	// In-flight requests are cancelled with the job, so workers return quickly.
+	select {
+	case <-done:
+	case <-ctx.Done():
+		logrus.Warn("Crawl is still stopping")
+	}
+
+	return nil
+}
+
+func (u *crawlUsecase) SetRate(ctx context.Context, rate domain.CrawlRate) error {
+	if rate.RequestsPerSecond < 0 {
+		return domain.InvalidCrawlRate
+	}
+
+	u.gate.SetRate(rate.RequestsPerSecond, rate.Burst)
+	logrus.Infof("Crawl rate set to %.2f rps, burst = %d", rate.RequestsPerSecond, rate.Burst)
+
+	return nil
+}
+
+func (u *crawlUsecase) GetStatus(ctx context.Context) (domain.CrawlStatus, error) {
+	ctx, cancel := context.WithTimeout(ctx, u.contextTimeout)
+	defer cancel()
+
+	status := u.parser.Stats()
+
+	queueLength, err := u.frontierRepo.Len(ctx)
+	if err != nil {
+		logrus.Errorf("Usecase: %v", err)
+		return domain.CrawlStatus{}, fmt.Errorf("usecase: %v", err)
+	}
+	status.QueueLength = queueLength
+
+	status.Rate.RequestsPerSecond, status.Rate.Burst = u.gate.Rate()
+
+	u.mutex.Lock()
+	defer u.mutex.Unlock()
+
+	status.StartedAt = u.startedAt
+	status.StoppedAt = u.stoppedAt
+	status.StopReason = string(u.stopReason)
+
+	switch {
+	case u.done == nil:
+		status.State = domain.CrawlStopped
+	case u.parser.Paused():
+		status.State = domain.CrawlPaused
+	default:
+		status.State = domain.CrawlRunning
+	}
+
+	return status, nil
+}
+
+func (u *crawlUsecase) running() bool {
+	u.mutex.Lock()
+	defer u.mutex.Unlock()
+
+	return u.done != nil
+}
@@ -0,0 +1,178 @@
+package usecase
+
+import (
+	"Kinopoisk-Parser/config"
+	"Kinopoisk-Parser/internal/domain"
+	movieParser "Kinopoisk-Parser/internal/parser"
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+)
+
+// fakeFrontier is an empty queue, so a seeds crawl idles until it is stopped.
+type fakeFrontier struct {
+	domain.FrontierRepository
+	mutex  sync.Mutex
+	state  map[string]uint64
+	length uint64
+	lenErr error
+}
+
+func (f *fakeFrontier) Pop(ctx context.Context, strategy string) (domain.FrontierItem, error) {
+	return domain.FrontierItem{}, domain.FrontierEmpty
+}
+
+func (f *fakeFrontier) ReleaseClaimed(ctx context.Context, before time.Time) (uint64, error) {
+	return 0, nil
+}
+
+func (f *fakeFrontier) Len(ctx context.Context) (uint64, error) {
+	return f.length, f.lenErr
+}
+
+func (f *fakeFrontier) GetState(ctx context.Context, key string) (uint64, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	value, ok := f.state[key]
+	if !ok {
+		return 0, domain.StateNotFound
+	}
+
+	return value, nil
+}
+
+func (f *fakeFrontier) SetState(ctx context.Context, key string, value uint64) error {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	f.state[key] = value
+
+	return nil
+}
+
+type fakeMovies struct {
+	domain.MovieUsecase
+}
+
+func (fakeMovies) Count(ctx context.Context) (uint64, error) {
+	return 0, nil
+}
+
+func newTestCrawl() (domain.CrawlUsecase, *fakeFrontier) {
+	frontier := &fakeFrontier{state: map[string]uint64{}, length: 7}
+	params := config.CrawlerParams{Mode: movieParser.SeedsMode}
+
+	parser := movieParser.NewParser(0, 1, params, nil, fakeMovies{}, nil, nil, nil, nil, nil, nil,
+		frontier, nil, nil)
+	gate := movieParser.NewRequestGate(2, 1, 0, 0, frontier)
+
+	return NewCrawlUsecase(parser, gate, frontier, time.Second), frontier
+}
+
+func status(t *testing.T, u domain.CrawlUsecase) domain.CrawlStatus {
+	t.Helper()
+
+	status, err := u.GetStatus(context.Background())
+	if err != nil {
+		t.Fatalf("get status: %v", err)
+	}
+
+	return status
+}
+
+func TestCrawlLifecycle(t *testing.T) {
+	u, _ := newTestCrawl()
+	ctx := context.Background()
+
+	if state := status(t, u).State; state != domain.CrawlStopped {
+		t.Fatalf("state before start = %s", state)
+	}
+
+	if err := u.Start(ctx); err != nil {
+		t.Fatalf("start: %v", err)
+	}
+	if err := u.Start(ctx); err != domain.CrawlAlreadyRunning {
+		t.Errorf("second start: err = %v", err)
+	}
+	if current := status(t, u); current.State != domain.CrawlRunning || current.StartedAt.IsZero() {
+		t.Errorf("status after start = %+v", current)
+	}
+
+	if err := u.Pause(ctx); err != nil {
+		t.Fatalf("pause: %v", err)
+	}
+	if state := status(t, u).State; state != domain.CrawlPaused {
+		t.Errorf("state after pause = %s", state)
+	}
+
+	if err := u.Resume(ctx); err != nil {
+		t.Fatalf("resume: %v", err)
+	}
+	if state := status(t, u).State; state != domain.CrawlRunning {
+		t.Errorf("state after resume = %s", state)
+	}
+
+	if err := u.Stop(ctx); err != nil {
+		t.Fatalf("stop: %v", err)
+	}
+	current := status(t, u)
+	if current.State != domain.CrawlStopped || current.StopReason != string(movieParser.StopCancelled) ||
+		current.StoppedAt.IsZero() {
+		t.Errorf("status after stop = %+v", current)
+	}
+
+	if err := u.Start(ctx); err != nil {
+		t.Fatalf("start after stop: %v", err)
+	}
+	if err := u.Stop(ctx); err != nil {
+		t.Fatalf("second stop: %v", err)
+	}
+}
+
+func TestCrawlNotRunning(t *testing.T) {
+	u, _ := newTestCrawl()
+	ctx := context.Background()
+
+	for name, control := range map[string]func(context.Context) error{
+		"pause":  u.Pause,
+		"resume": u.Resume,
+		"stop":   u.Stop,
+	} {
+		if err := control(ctx); err != domain.CrawlNotRunning {
+			t.Errorf("%s a stopped crawl: err = %v", name, err)
+		}
+	}
+}
+
+func TestCrawlSetRate(t *testing.T) {
+	u, _ := newTestCrawl()
+	ctx := context.Background()
+
+	if err := u.SetRate(ctx, domain.CrawlRate{RequestsPerSecond: -1}); err != domain.InvalidCrawlRate {
+		t.Errorf("negative rate: err = %v", err)
+	}
+
+	if err := u.SetRate(ctx, domain.CrawlRate{RequestsPerSecond: 3.5, Burst: 4}); err != nil {
+		t.Fatalf("set rate: %v", err)
+	}
+
+	current := status(t, u)
+	if current.Rate.RequestsPerSecond != 3.5 || current.Rate.Burst != 4 {
+		t.Errorf("rate = %+v", current.Rate)
+	}
+	if current.QueueLength != 7 {
+		t.Errorf("queue length = %d", current.QueueLength)
+	}
+}
+
+func TestCrawlStatusQueueError(t *testing.T) {
+	u, frontier := newTestCrawl()
+	frontier.lenErr = errors.New("connection refused")
+
+	if _, err := u.GetStatus(context.Background()); err == nil {
+		t.Error("status is returned without the queue length")
+	}
+}
@@ -0,0 +1,27 @@
+package query
+
+import (
+	"net/http"
+	"strconv"
+)
+
+const (
+	DefaultLimit  uint64 = 20
+	DefaultOffset uint64 = 0
+)
+
+// Uint returns the query parameter as a number, or the default value when it
+// is missing or malformed.
+func Uint(r *http.Request, name string, defaultValue uint64) uint64 {
+	value, err := strconv.ParseUint(r.URL.Query().Get(name), 10, 64)
+	if err != nil {
+		return defaultValue
+	}
+
+	return value
+}
+
+// Page returns the limit and offset query parameters of a paginated list.
+func Page(r *http.Request) (uint64, uint64) {
+	return Uint(r, "limit", DefaultLimit), Uint(r, "offset", DefaultOffset)
+}
@@ -0,0 +1,60 @@
+package domain
+
+import "context"
+
+const (
+	AwardWon       = "won"
+	AwardNominated = "nominated"
+)
+
+// Award is a nomination of a movie, or of a person for a movie. Awards of a
+// movie itself have no PersonID.
+type Award struct {
+	ID         uint64 `json:"id"`
+	MovieID    uint64 `json:"movie_id"`
+	PersonID   uint64 `json:"person_id,omitempty"`
+	Award      string `json:"award"`
+	Nomination string `json:"nomination"`
+	Year       uint64 `json:"year"`
+	Winner     bool   `json:"winner"`
+}
+
+// AwardFilter selects awards by title and outcome, "nominated" meaning the
+// nominations that did not win. PersonID keeps the awards of movies featuring
+// the person.
+type AwardFilter struct {
+	Award    string
+	Outcome  string
+	PersonID uint64
+}
+
+// Winner returns the winner flag the outcome filters on, nil for any outcome.
+func (f AwardFilter) Winner() *bool {
+	var winner bool
+	switch f.Outcome {
+	case AwardWon:
+		winner = true
+	case AwardNominated:
+		winner = false
+	default:
+		return nil
+	}
+
+	return &winner
+}
+
+type AwardUsecase interface {
+	GetMovieAwards(ctx context.Context, movieID uint64, filter AwardFilter) ([]Award, error)
+	GetPersonAwards(ctx context.Context, personID uint64, filter AwardFilter) ([]Award, error)
+	Find(ctx context.Context, filter AwardFilter, limit, offset uint64) ([]Award, error)
+	SaveMovieAwards(ctx context.Context, movieID uint64, awards []Award) error
+	SavePersonAwards(ctx context.Context, personID uint64, awards []Award) error
+}
+
+type AwardRepository interface {
+	GetByMovie(ctx context.Context, movieID uint64, filter AwardFilter) ([]Award, error)
+	GetByPerson(ctx context.Context, personID uint64, filter AwardFilter) ([]Award, error)
+	Find(ctx context.Context, filter AwardFilter, limit, offset uint64) ([]Award, error)
+	ReplaceMovieAwards(ctx context.Context, movieID uint64, awards []Award) error
+	ReplacePersonAwards(ctx context.Context, personID uint64, awards []Award) error
+}
@@ -0,0 +1,45 @@
+package domain
+
+import (
+	"context"
+	"time"
+)
+
+// BaseCurrency is the base currency of the exchange rates, its rate is 1.
+const BaseCurrency = "$"
+
+type Money struct {
+	Value    uint64 `json:"value"`
+	Currency string `json:"currency"`
+}
+
+type BoxOffice struct {
+	MovieID uint64 `json:"movie_id"`
+	Budget  Money  `json:"budget"`
+	World   Money  `json:"world"`
+	Usa     Money  `json:"usa"`
+	Russia  Money  `json:"russia"`
+}
+
+// ExchangeRate is the price of one unit of Currency in the common base
+// currency of the table. Currencies are keyed as kinopoisk returns them,
+// e.g. "$" or "₽".
+type ExchangeRate struct {
+	Currency  string    `json:"currency"`
+	Rate      float64   `json:"rate"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+type BoxOfficeUsecase interface {
+	GetBoxOffice(ctx context.Context, movieID uint64, currency string) (BoxOffice, error)
+	GetRates(ctx context.Context) ([]ExchangeRate, error)
+	SetRate(ctx context.Context, rate ExchangeRate) error
+}
+
+type ExchangeRateRepository interface {
+	GetAll(ctx context.Context) ([]ExchangeRate, error)
+	Get(ctx context.Context, currency string) (ExchangeRate, error)
+	Set(ctx context.Context, rate *ExchangeRate) error
+	// Seed stores the rate only if its currency has none yet.
+	Seed(ctx context.Context, rate *ExchangeRate) error
+}
@@ -2,6 +2,7 @@ package domain
 
 import (
 	"Kinopoisk-Parser/config"
+	"context"
 	"database/sql"
 	"fmt"
 	_ "github.com/lib/pq"
@@ -30,3 +31,22 @@ func InitNeo4jConnectionByParams(params config.DatabaseConnectionParams) neo4j.D
 
 	return driver
 }
+
+// neo4jConstraints make the crawl state nodes unique, so concurrent MERGEs of
+// the same key cannot create two nodes.
+var neo4jConstraints = []string{
+	"CREATE CONSTRAINT frontier_item_key IF NOT EXISTS FOR (f:FrontierItem) REQUIRE (f.Kind, f.ID) IS UNIQUE",
+	"CREATE CONSTRAINT visited_key IF NOT EXISTS FOR (v:Visited) REQUIRE (v.Kind, v.ID) IS UNIQUE",
+	"CREATE CONSTRAINT crawl_state_key IF NOT EXISTS FOR (s:CrawlState) REQUIRE s.Key IS UNIQUE",
+}
+
+func InitNeo4jSchema(ctx context.Context, driver neo4j.DriverWithContext) error {
+	for _, constraint := range neo4jConstraints {
+		_, err := neo4j.ExecuteQuery(ctx, driver, constraint, map[string]any{}, neo4j.EagerResultTransformer)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,47 @@
+package domain
+
+import (
+	"context"
+	"time"
+)
+
+const (
+	CrawlRunning = "running"
+	CrawlPaused  = "paused"
+	CrawlStopped = "stopped"
+)
+
+type CrawlRate struct {
+	RequestsPerSecond float64 `json:"requests_per_second"`
+	Burst             uint64  `json:"burst"`
+}
+
+type CrawlStatus struct {
+	State            string         `json:"state"`
+	StopReason       string         `json:"stop_reason,omitempty"`
+	StartedAt        time.Time      `json:"started_at"`
+	StoppedAt        time.Time      `json:"stopped_at"`
+	QueueLength      uint64         `json:"queue_length"`
+	ProcessedMovies  uint64         `json:"processed_movies"`
+	ProcessedPersons uint64         `json:"processed_persons"`
+	ProcessedPages   uint64         `json:"processed_pages"`
+	ProcessedSeasons uint64         `json:"processed_seasons"`
+	ProcessedAwards  uint64         `json:"processed_awards"`
+	ProcessedReviews uint64         `json:"processed_reviews"`
+	ProcessedStudios uint64         `json:"processed_studios"`
+	StoredMovies     uint64         `json:"stored_movies"`
+	ChangedFields    uint64         `json:"changed_fields"`
+	Errors           uint64         `json:"errors"`
+	LastError        string         `json:"last_error,omitempty"`
+	Current          []FrontierItem `json:"current"`
+	Rate             CrawlRate      `json:"rate"`
+}
+
+type CrawlUsecase interface {
+	Start(ctx context.Context) error
+	Pause(ctx context.Context) error
+	Resume(ctx context.Context) error
+	Stop(ctx context.Context) error
+	SetRate(ctx context.Context, rate CrawlRate) error
+	GetStatus(ctx context.Context) (CrawlStatus, error)
+}
@@ -1,8 +1,12 @@
 package domain
 
+import "time"
+
 type MovieDTO struct {
 	Id               int    `json:"id"`
 	Name             string `json:"name"`
+	AlternativeName  string `json:"alternativeName"`
+	EnName           string `json:"enName"`
 	Type             string `json:"type"`
 	TypeNumber       int    `json:"typeNumber"`
 	Year             int    `json:"year"`
@@ -10,6 +14,7 @@ type MovieDTO struct {
 	ShortDescription string `json:"shortDescription"`
 	Slogan           string `json:"slogan"`
 	Status           string `json:"status"`
+	IsSeries         bool   `json:"isSeries"`
 	Rating           struct {
 		Kp                 float64 `json:"kp"`
 		Imdb               float64 `json:"imdb"`
@@ -18,8 +23,16 @@ type MovieDTO struct {
 		RussianFilmCritics float64 `json:"russianFilmCritics"`
 		Await              float64 `json:"await"`
 	} `json:"rating"`
+	Votes struct {
+		Kp   int `json:"kp"`
+		Imdb int `json:"imdb"`
+	} `json:"votes"`
 	MovieLength int `json:"movieLength"`
-	Persons     []struct {
+	AgeRating   int `json:"ageRating"`
+	Genres      []struct {
+		Name string `json:"name"`
+	} `json:"genres"`
+	Persons []struct {
 		Id           int    `json:"id"`
 		Photo        string `json:"photo"`
 		Name         string `json:"name"`
@@ -28,6 +41,9 @@ type MovieDTO struct {
 		Profession   string `json:"profession"`
 		EnProfession string `json:"enProfession"`
 	} `json:"persons"`
+	Countries []struct {
+		Name string `json:"name"`
+	} `json:"countries"`
 	Budget struct {
 		Value    int    `json:"value"`
 		Currency string `json:"currency"`
@@ -37,16 +53,142 @@ type MovieDTO struct {
 			Value    int    `json:"value"`
 			Currency string `json:"currency"`
 		} `json:"world"`
+		Usa struct {
+			Value    int    `json:"value"`
+			Currency string `json:"currency"`
+		} `json:"usa"`
+		Russia struct {
+			Value    int    `json:"value"`
+			Currency string `json:"currency"`
+		} `json:"russia"`
 	} `json:"fees"`
+	ReviewInfo struct {
+		Count      int    `json:"count"`
+		Positive   int    `json:"positive"`
+		Percentage string `json:"percentage"`
+	} `json:"reviewInfo"`
+	Premiere struct {
+		World   *time.Time `json:"world"`
+		Russia  *time.Time `json:"russia"`
+		Digital *time.Time `json:"digital"`
+		Dvd     *time.Time `json:"dvd"`
+	} `json:"premiere"`
+	SequelsAndPrequels []LinkedMovieDTO `json:"sequelsAndPrequels"`
+	SimilarMovies      []LinkedMovieDTO `json:"similarMovies"`
+}
+
+type LinkedMovieDTO struct {
+	Id              int    `json:"id"`
+	Name            string `json:"name"`
+	EnName          string `json:"enName"`
+	AlternativeName string `json:"alternativeName"`
+	Type            string `json:"type"`
+	Year            int    `json:"year"`
+	Rating          struct {
+		Kp   float64 `json:"kp"`
+		Imdb float64 `json:"imdb"`
+	} `json:"rating"`
+	Votes struct {
+		Kp   int `json:"kp"`
+		Imdb int `json:"imdb"`
+	} `json:"votes"`
 }
 
 type PersonDTO struct {
-	Id     int    `json:"id"`
-	Name   string `json:"name,omitempty"`
-	EnName string `json:"enName,omitempty"`
-	Growth int    `json:"growth,omitempty"`
-	Age    int    `json:"age,omitempty"`
+	Id         int        `json:"id"`
+	Name       string     `json:"name,omitempty"`
+	EnName     string     `json:"enName,omitempty"`
+	Photo      string     `json:"photo,omitempty"`
+	Sex        string     `json:"sex,omitempty"`
+	Growth     int        `json:"growth,omitempty"`
+	Age        int        `json:"age,omitempty"`
+	Birthday   *time.Time `json:"birthday,omitempty"`
+	Death      *time.Time `json:"death,omitempty"`
+	BirthPlace []struct {
+		Value string `json:"value"`
+	} `json:"birthPlace,omitempty"`
 	Movies []struct {
-		Id int `json:"id"`
+		Id     int     `json:"id"`
+		Rating float64 `json:"rating"`
+		Votes  struct {
+			Kp   int `json:"kp"`
+			Imdb int `json:"imdb"`
+		} `json:"votes"`
 	} `json:"movies,omitempty"`
 }
+
+type SeasonDTO struct {
+	MovieId       int        `json:"movieId"`
+	Number        int        `json:"number"`
+	EpisodesCount int        `json:"episodesCount"`
+	Name          string     `json:"name"`
+	EnName        string     `json:"enName"`
+	Description   string     `json:"description"`
+	AirDate       *time.Time `json:"airDate"`
+	Episodes      []struct {
+		Number      int        `json:"number"`
+		Name        string     `json:"name"`
+		EnName      string     `json:"enName"`
+		Description string     `json:"description"`
+		AirDate     *time.Time `json:"airDate"`
+		Date        *time.Time `json:"date"`
+	} `json:"episodes"`
+}
+
+type SeasonPageDTO = PageDTO[SeasonDTO]
+
+type AwardDTO struct {
+	MovieId    int `json:"movieId"`
+	PersonId   int `json:"personId"`
+	Nomination struct {
+		Title string `json:"title"`
+		Award struct {
+			Title string `json:"title"`
+			Year  int    `json:"year"`
+		} `json:"award"`
+	} `json:"nomination"`
+	Winning bool `json:"winning"`
+	Movie   *struct {
+		Id   int    `json:"id"`
+		Name string `json:"name"`
+	} `json:"movie"`
+}
+
+type AwardPageDTO = PageDTO[AwardDTO]
+
+type ReviewDTO struct {
+	Id      int        `json:"id"`
+	MovieId int        `json:"movieId"`
+	Title   string     `json:"title"`
+	Type    string     `json:"type"`
+	Review  string     `json:"review"`
+	Author  string     `json:"author"`
+	Date    *time.Time `json:"date"`
+}
+
+type ReviewPageDTO = PageDTO[ReviewDTO]
+
+type StudioDTO struct {
+	Id      int    `json:"id"`
+	Title   string `json:"title"`
+	Type    string `json:"type"`
+	SubType string `json:"subType"`
+}
+
+type StudioPageDTO = PageDTO[StudioDTO]
+
+type MoviePageDTO = PageDTO[MovieDTO]
+
+// PageDTO is one page of a paged kinopoisk endpoint.
+type PageDTO[T any] struct {
+	Docs  []T `json:"docs"`
+	Total int `json:"total"`
+	Limit int `json:"limit"`
+	Page  int `json:"page"`
+	Pages int `json:"pages"`
+}
+
+// Last reports whether the page with the given number is the last one.
+func (p PageDTO[T]) Last(page uint64) bool {
+	return len(p.Docs) == 0 || page >= uint64(p.Pages)
+}
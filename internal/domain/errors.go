@@ -6,4 +6,30 @@ var (
 	PersonNotFound     = fmt.Errorf("not found")
 	MovieNotFound      = fmt.Errorf("not found")
 	ProfessionNotFound = fmt.Errorf("not found")
+	StateNotFound      = fmt.Errorf("not found")
+	SeasonNotFound     = fmt.Errorf("not found")
+	StudioNotFound     = fmt.Errorf("not found")
+	RateNotFound       = fmt.Errorf("exchange rate not found")
+	UnknownRole        = fmt.Errorf("unknown role")
+	UnknownRelation    = fmt.Errorf("unknown relation")
+	UnknownReviewType  = fmt.Errorf("unknown review type")
+	UnknownStudioType  = fmt.Errorf("unknown studio type")
+	UnknownPremiere    = fmt.Errorf("unknown premiere country")
+	FrontierEmpty      = fmt.Errorf("frontier is empty")
+
+	RequestBudgetExhausted = fmt.Errorf("daily request budget exhausted")
+	UpstreamNotFound       = fmt.Errorf("upstream: not found")
+	UpstreamRateLimited    = fmt.Errorf("upstream: rate limited")
+	UpstreamServerError    = fmt.Errorf("upstream: server error")
+	UpstreamBadStatus      = fmt.Errorf("upstream: unexpected status")
+	UpstreamUnauthorized   = fmt.Errorf("upstream: token rejected")
+	TokensExhausted        = fmt.Errorf("all api tokens are exhausted")
+
+	CrawlAlreadyRunning = fmt.Errorf("crawl is already running")
+	CrawlNotRunning     = fmt.Errorf("crawl is not running")
+	InvalidCrawlRate    = fmt.Errorf("invalid crawl rate")
+
+	InvalidExchangeRate = fmt.Errorf("invalid exchange rate")
+	InvalidAwardFilter  = fmt.Errorf("invalid award filter")
+	InvalidDateRange    = fmt.Errorf("invalid date range")
 )
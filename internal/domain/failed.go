@@ -0,0 +1,25 @@
+package domain
+
+import (
+	"context"
+	"time"
+)
+
+type FailedItem struct {
+	ID       uint64    `json:"id"`
+	Kind     uint64    `json:"kind"`
+	Error    string    `json:"error"`
+	Attempts uint64    `json:"attempts"`
+	FailedAt time.Time `json:"failed_at"`
+}
+
+type FailedUsecase interface {
+	GetFailed(ctx context.Context, limit, offset uint64) ([]FailedItem, error)
+	Replay(ctx context.Context) (uint64, error)
+}
+
+type FailedRepository interface {
+	GetFailed(ctx context.Context, limit, offset uint64) ([]FailedItem, error)
+	Add(ctx context.Context, kind, id uint64, reason string) error
+	Delete(ctx context.Context, kind, id uint64) error
+}
@@ -0,0 +1,67 @@
+package domain
+
+import (
+	"context"
+	"time"
+)
+
+const (
+	MovieItem  uint64 = 1
+	PersonItem uint64 = 2
+	PageItem   uint64 = 3
+	SeasonItem uint64 = 4
+
+	MovieAwardsItem  uint64 = 5
+	PersonAwardsItem uint64 = 6
+
+	ReviewItem uint64 = 7
+	StudioItem uint64 = 8
+)
+
+const (
+	SequentialIndexKey = "sequential_index"
+	DailyRequestsKey   = "daily_requests"
+	PageIndexKey       = "page_index"
+	ChangedFieldsKey   = "refresh_changed_fields"
+	StoreScopeKey      = "store_scope"
+)
+
+const (
+	BFSStrategy    = "bfs"
+	DFSStrategy    = "dfs"
+	RatingStrategy = "rating"
+	VotesStrategy  = "votes"
+)
+
+type FrontierItem struct {
+	ID       uint64  `json:"id"`
+	Kind     uint64  `json:"kind"`
+	Priority float64 `json:"priority"`
+	Depth    uint64  `json:"depth"`
+	MaxDepth uint64  `json:"max_depth"`
+}
+
+type Seeds struct {
+	MovieIDs  []uint64 `json:"movie_ids"`
+	PersonIDs []uint64 `json:"person_ids"`
+	MaxDepth  uint64   `json:"max_depth"`
+}
+
+type SeedUsecase interface {
+	Add(ctx context.Context, seeds Seeds) (uint64, error)
+}
+
+// FrontierRepository keeps the crawl queue. Pop leases an item instead of
+// removing it: the item stays stored until Done, so an item popped by a crawl
+// that dies before finishing it is handed out again after ReleaseClaimed.
+type FrontierRepository interface {
+	Push(ctx context.Context, item FrontierItem) error
+	Pop(ctx context.Context, strategy string) (FrontierItem, error)
+	Done(ctx context.Context, kind, id uint64) error
+	Release(ctx context.Context, item FrontierItem) error
+	ReleaseClaimed(ctx context.Context, before time.Time) (uint64, error)
+	Len(ctx context.Context) (uint64, error)
+	GetState(ctx context.Context, key string) (uint64, error)
+	SetState(ctx context.Context, key string, value uint64) error
+	IncState(ctx context.Context, key string, delta uint64) (uint64, error)
+}
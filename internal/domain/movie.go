@@ -1,24 +1,117 @@
 package domain
 
-import "context"
+import (
+	"context"
+	"time"
+)
+
+type MovieRatings struct {
+	Kp                 float64 `json:"kp"`
+	Imdb               float64 `json:"imdb"`
+	Tmdb               float64 `json:"tmdb"`
+	FilmCritics        float64 `json:"film_critics"`
+	RussianFilmCritics float64 `json:"russian_film_critics"`
+	Await              float64 `json:"await"`
+}
+
+// MovieReviewInfo keeps the review counts of a movie as reported by kinopoisk,
+// the percentage of positive reviews is a string like "87%".
+type MovieReviewInfo struct {
+	Count      uint64 `json:"count"`
+	Positive   uint64 `json:"positive"`
+	Percentage string `json:"percentage"`
+}
 
 type MovieBaseInfo struct {
-	ID       uint64  `json:"id"`
-	Title    string  `json:"title"`
-	Year     uint64  `json:"year"`
-	Tagline  string  `json:"tagline"`
-	Duration uint64  `json:"duration"`
-	Rating   float64 `json:"rating"`
-	Budget   uint64  `json:"budget"`
-	Gross    uint64  `json:"gross"`
+	ID               uint64          `json:"id"`
+	Title            string          `json:"title"`
+	AlternativeName  string          `json:"alternative_name"`
+	EnName           string          `json:"en_name"`
+	Type             string          `json:"type"`
+	IsSeries         bool            `json:"is_series"`
+	Status           string          `json:"status"`
+	Year             uint64          `json:"year"`
+	Tagline          string          `json:"tagline"`
+	Description      string          `json:"description"`
+	ShortDescription string          `json:"short_description"`
+	Duration         uint64          `json:"duration"`
+	AgeRating        uint64          `json:"age_rating"`
+	Rating           float64         `json:"rating"`
+	Ratings          MovieRatings    `json:"ratings"`
+	ReviewInfo       MovieReviewInfo `json:"review_info"`
+	Budget           uint64          `json:"budget"`
+	BudgetCurrency   string          `json:"budget_currency"`
+	Gross            uint64          `json:"gross"`
+	GrossCurrency    string          `json:"gross_currency"`
+	GrossUsa         uint64          `json:"gross_usa"`
+	GrossUsaCurrency string          `json:"gross_usa_currency"`
+	GrossRus         uint64          `json:"gross_rus"`
+	GrossRusCurrency string          `json:"gross_rus_currency"`
+	Genres           []string        `json:"genres"`
+	Countries        []string        `json:"countries"`
+	FetchedAt        time.Time       `json:"fetched_at"`
+}
+
+// Credit is a person as credited on a movie: the character played and the
+// position in the billing order of the role.
+type Credit struct {
+	Person
+	Character string `json:"character,omitempty"`
+	Position  uint64 `json:"position"`
 }
 
+// RoleCredit is a Credit together with the catalogue role it was made in.
+type RoleCredit struct {
+	Credit
+	Role uint64
+}
+
+// Movie keeps the four main roles in their own fields, credits in the other
+// roles of the catalogue are grouped in Crew by the role enName.
 type Movie struct {
-	BaseInfo  MovieBaseInfo `json:"info"`
-	Producers []Person      `json:"producer"`
-	Directors []Person      `json:"directors"`
-	Actors    []Person      `json:"actors"`
-	Writers   []Person      `json:"writers"`
+	BaseInfo  MovieBaseInfo       `json:"info"`
+	Producers []Credit            `json:"producer"`
+	Directors []Credit            `json:"directors"`
+	Actors    []Credit            `json:"actors"`
+	Writers   []Credit            `json:"writers"`
+	Crew      map[string][]Credit `json:"crew,omitempty"`
+}
+
+func (m *Movie) Credits(role Role) []Credit {
+	switch role.ID {
+	case DirectorRole:
+		return m.Directors
+	case ProducerRole:
+		return m.Producers
+	case WriterRole:
+		return m.Writers
+	case ActorsRole:
+		return m.Actors
+	default:
+		return m.Crew[role.EnName]
+	}
+}
+
+func (m *Movie) SetCredits(role Role, credits []Credit) {
+	switch role.ID {
+	case DirectorRole:
+		m.Directors = credits
+	case ProducerRole:
+		m.Producers = credits
+	case WriterRole:
+		m.Writers = credits
+	case ActorsRole:
+		m.Actors = credits
+	default:
+		if len(credits) == 0 {
+			delete(m.Crew, role.EnName)
+			return
+		}
+		if m.Crew == nil {
+			m.Crew = map[string][]Credit{}
+		}
+		m.Crew[role.EnName] = credits
+	}
 }
 
 type MovieRepoDTO struct {
@@ -34,7 +127,11 @@ type MovieUsecase interface {
 	GetByID(ctx context.Context, id uint64) (Movie, error)
 	GetByTitle(ctx context.Context, title string) (Movie, error)
 	GetMovies(ctx context.Context, limit, offset uint64) ([]Movie, error)
+	Count(ctx context.Context) (uint64, error)
+	GetStale(ctx context.Context, kind uint64, before time.Time, afterID, limit uint64) ([]uint64, error)
 	Add(ctx context.Context, m *Movie) error
+	Update(ctx context.Context, m *Movie) (uint64, error)
+	SavePerson(ctx context.Context, p *Person) (uint64, error)
 	Delete(ctx context.Context, id uint64) error
 }
 
@@ -42,6 +139,9 @@ type MovieRepository interface {
 	GetByID(ctx context.Context, id uint64) (MovieBaseInfo, error)
 	GetByTitle(ctx context.Context, title string) (MovieBaseInfo, error)
 	GetMovies(ctx context.Context, limit, offset uint64) ([]MovieBaseInfo, error)
+	Count(ctx context.Context) (uint64, error)
+	GetStale(ctx context.Context, before time.Time, afterID, limit uint64) ([]uint64, error)
 	Add(ctx context.Context, m *MovieBaseInfo) error
+	Update(ctx context.Context, m *MovieBaseInfo) error
 	Delete(ctx context.Context, id uint64) error
 }
@@ -1,18 +1,31 @@
 package domain
 
-import "context"
+import (
+	"context"
+	"time"
+)
 
 type Person struct {
-	ID       uint64 `json:"id"`
-	FullName string `json:"full_name"`
-	Height   uint64 `json:"height,omitempty"`
-	Age      uint64 `json:"age,omitempty"`
+	ID         uint64     `json:"id"`
+	FullName   string     `json:"full_name"`
+	EnName     string     `json:"en_name,omitempty"`
+	Photo      string     `json:"photo,omitempty"`
+	Sex        string     `json:"sex,omitempty"`
+	Height     uint64     `json:"height,omitempty"`
+	Age        uint64     `json:"age,omitempty"`
+	Birthday   *time.Time `json:"birthday,omitempty"`
+	Death      *time.Time `json:"death,omitempty"`
+	Birthplace string     `json:"birthplace,omitempty"`
+	FetchedAt  time.Time  `json:"fetched_at"`
 }
 
 type PersonRepository interface {
 	GetByID(ctx context.Context, id uint64) (Person, error)
 	GetByFullName(ctx context.Context, title string) (Person, error)
 	GetPersons(ctx context.Context, limit, offset uint64) ([]Person, error)
+	GetByMovie(ctx context.Context, movieID uint64) ([]RoleCredit, error)
+	GetStale(ctx context.Context, before time.Time, afterID, limit uint64) ([]uint64, error)
 	Add(ctx context.Context, m *Person) error
+	Update(ctx context.Context, m *Person) error
 	Delete(ctx context.Context, id uint64) error
 }
@@ -0,0 +1,53 @@
+package domain
+
+import (
+	"context"
+	"time"
+)
+
+const (
+	WorldPremiere   = "world"
+	RussiaPremiere  = "russia"
+	DigitalPremiere = "digital"
+	DVDPremiere     = "dvd"
+)
+
+// Premiere is the release date of a movie in one market: the world or Russian
+// premiere, or the digital or DVD release.
+type Premiere struct {
+	MovieID uint64    `json:"movie_id"`
+	Country string    `json:"country"`
+	Date    time.Time `json:"date"`
+}
+
+// PremiereFilter selects premieres by market and by date, both bounds of the
+// range are inclusive and optional.
+type PremiereFilter struct {
+	Country string
+	From    *time.Time
+	To      *time.Time
+}
+
+// Before returns the exclusive upper bound of the range, the day after To, so
+// a premiere at any time of the last day is included. It is nil without To.
+func (f PremiereFilter) Before() *time.Time {
+	if f.To == nil {
+		return nil
+	}
+
+	before := f.To.AddDate(0, 0, 1)
+
+	return &before
+}
+
+type PremiereUsecase interface {
+	GetByMovie(ctx context.Context, movieID uint64) ([]Premiere, error)
+	Find(ctx context.Context, filter PremiereFilter, limit, offset uint64) ([]Premiere, error)
+	Save(ctx context.Context, movieID uint64, premieres []Premiere) error
+}
+
+type PremiereRepository interface {
+	GetByMovie(ctx context.Context, movieID uint64) ([]Premiere, error)
+	Find(ctx context.Context, filter PremiereFilter, limit, offset uint64) ([]Premiere, error)
+	Replace(ctx context.Context, movieID uint64, premieres []Premiere) error
+}
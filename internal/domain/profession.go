@@ -3,26 +3,89 @@ package domain
 import "context"
 
 const (
-	DirectorRole uint64 = 1
-	ProducerRole uint64 = 2
-	WriterRole   uint64 = 3
-	ActorsRole   uint64 = 4
+	DirectorRole      uint64 = 1
+	ProducerRole      uint64 = 2
+	WriterRole        uint64 = 3
+	ActorsRole        uint64 = 4
+	ComposerRole      uint64 = 5
+	OperatorRole      uint64 = 6
+	EditorRole        uint64 = 7
+	DesignerRole      uint64 = 8
+	VoiceActorRole    uint64 = 9
+	TranslatorRole    uint64 = 10
+	VoiceDirectorRole uint64 = 11
 )
 
+// Role is a profession as returned by kinopoisk.dev. ID is the movie_role
+// value in PostgreSQL and RelationType is the relationship type in Neo4j.
+type Role struct {
+	ID           uint64 `json:"id"`
+	Name         string `json:"name"`
+	EnName       string `json:"en_name"`
+	RelationType string `json:"relation_type"`
+}
+
+// Roles is the role catalogue. The roles table in PostgreSQL is written from
+// it on startup, see ProfessionRepository.SyncRoles.
+var Roles = []Role{
+	{ID: DirectorRole, Name: "режиссеры", EnName: "director", RelationType: "DIRECTED"},
+	{ID: ProducerRole, Name: "продюсеры", EnName: "producer", RelationType: "PRODUCED"},
+	{ID: WriterRole, Name: "сценаристы", EnName: "writer", RelationType: "WROTE"},
+	{ID: ActorsRole, Name: "актеры", EnName: "actor", RelationType: "ACTED_IN"},
+	{ID: ComposerRole, Name: "композиторы", EnName: "composer", RelationType: "COMPOSED"},
+	{ID: OperatorRole, Name: "операторы", EnName: "operator", RelationType: "SHOT"},
+	{ID: EditorRole, Name: "монтажеры", EnName: "editor", RelationType: "EDITED"},
+	{ID: DesignerRole, Name: "художники", EnName: "designer", RelationType: "DESIGNED"},
+	{ID: VoiceActorRole, Name: "актеры дубляжа", EnName: "voice_actor", RelationType: "VOICED"},
+	{ID: TranslatorRole, Name: "переводчики", EnName: "translator", RelationType: "TRANSLATED"},
+	{ID: VoiceDirectorRole, Name: "режиссеры дубляжа", EnName: "voice_director", RelationType: "DIRECTED_DUBBING"},
+}
+
+func RoleByID(id uint64) (Role, error) {
+	for _, role := range Roles {
+		if role.ID == id {
+			return role, nil
+		}
+	}
+
+	return Role{}, UnknownRole
+}
+
+func RoleByEnName(enName string) (Role, error) {
+	for _, role := range Roles {
+		if role.EnName == enName {
+			return role, nil
+		}
+	}
+
+	return Role{}, UnknownRole
+}
+
+func RoleByRelationType(relationType string) (Role, error) {
+	for _, role := range Roles {
+		if role.RelationType == relationType {
+			return role, nil
+		}
+	}
+
+	return Role{}, UnknownRole
+}
+
 type Profession struct {
-	ID       uint64 `json:"id"`
-	MovieID  uint64 `json:"movie_id"`
-	PersonID uint64 `json:"person_id"`
-	Role     uint64 `json:"role"`
+	ID        uint64 `json:"id"`
+	MovieID   uint64 `json:"movie_id"`
+	PersonID  uint64 `json:"person_id"`
+	Role      uint64 `json:"role"`
+	Character string `json:"character,omitempty"`
+	Position  uint64 `json:"position"`
 }
 
 type ProfessionRepository interface {
 	GetIDByParams(ctx context.Context, movieID, personID, role uint64) (uint64, error)
 	GetByID(ctx context.Context, id uint64) (Profession, error)
-	GetDirectorsByMovie(ctx context.Context, movieID uint64) ([]Profession, error)
-	GetProducersByMovie(ctx context.Context, movieID uint64) ([]Profession, error)
-	GetWritersByMovie(ctx context.Context, movieID uint64) ([]Profession, error)
-	GetActorsByMovie(ctx context.Context, movieID uint64) ([]Profession, error)
-	Add(ctx context.Context, movieID, personID, role uint64) error
+	GetByMovie(ctx context.Context, movieID, role uint64) ([]Profession, error)
+	Add(ctx context.Context, p *Profession) error
+	Remove(ctx context.Context, movieID, personID, role uint64) error
 	Delete(ctx context.Context, id uint64) error
+	SyncRoles(ctx context.Context, roles []Role) error
 }
@@ -0,0 +1,34 @@
+package domain
+
+import "context"
+
+const (
+	// SequelRelation links a movie to the other parts of its franchise, the API
+	// does not tell sequels from prequels.
+	SequelRelation  = "sequel"
+	SimilarRelation = "similar"
+)
+
+// MovieRelationTypes maps a relation to its relationship type in Neo4j.
+var MovieRelationTypes = map[string]string{
+	SequelRelation:  "SEQUEL_OF",
+	SimilarRelation: "SIMILAR_TO",
+}
+
+type MovieRelation struct {
+	MovieID   uint64 `json:"movie_id"`
+	RelatedID uint64 `json:"related_id"`
+	Type      string `json:"type"`
+	Name      string `json:"name"`
+	Year      uint64 `json:"year"`
+}
+
+type RelationUsecase interface {
+	GetRelated(ctx context.Context, movieID uint64, relationType string) ([]MovieRelation, error)
+	Save(ctx context.Context, movieID uint64, relations []MovieRelation) error
+}
+
+type RelationRepository interface {
+	GetByMovie(ctx context.Context, movieID uint64, relationType string) ([]MovieRelation, error)
+	Replace(ctx context.Context, movieID uint64, relationType string, relations []MovieRelation) error
+}
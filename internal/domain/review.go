@@ -0,0 +1,39 @@
+package domain
+
+import (
+	"context"
+	"time"
+)
+
+const (
+	PositiveReview = "positive"
+	NegativeReview = "negative"
+	NeutralReview  = "neutral"
+)
+
+// ReviewTypes maps the review types returned by kinopoisk to the stored ones.
+var ReviewTypes = map[string]string{
+	"Позитивный":  PositiveReview,
+	"Негативный":  NegativeReview,
+	"Нейтральный": NeutralReview,
+}
+
+type Review struct {
+	ID      uint64     `json:"id"`
+	MovieID uint64     `json:"movie_id"`
+	Title   string     `json:"title"`
+	Review  string     `json:"review"`
+	Type    string     `json:"type"`
+	Author  string     `json:"author"`
+	Date    *time.Time `json:"date"`
+}
+
+type ReviewUsecase interface {
+	GetReviews(ctx context.Context, movieID uint64, reviewType string, limit, offset uint64) ([]Review, error)
+	Save(ctx context.Context, movieID uint64, reviews []Review) error
+}
+
+type ReviewRepository interface {
+	GetByMovie(ctx context.Context, movieID uint64, reviewType string, limit, offset uint64) ([]Review, error)
+	Replace(ctx context.Context, movieID uint64, reviews []Review) error
+}
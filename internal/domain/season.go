@@ -0,0 +1,41 @@
+package domain
+
+import (
+	"context"
+	"time"
+)
+
+type Episode struct {
+	MovieID      uint64     `json:"movie_id"`
+	SeasonNumber uint64     `json:"season_number"`
+	Number       uint64     `json:"number"`
+	Name         string     `json:"name"`
+	EnName       string     `json:"en_name"`
+	AirDate      *time.Time `json:"air_date"`
+	Description  string     `json:"description"`
+}
+
+type Season struct {
+	MovieID       uint64     `json:"movie_id"`
+	Number        uint64     `json:"number"`
+	Name          string     `json:"name"`
+	EnName        string     `json:"en_name"`
+	EpisodesCount uint64     `json:"episodes_count"`
+	AirDate       *time.Time `json:"air_date"`
+	Description   string     `json:"description"`
+	Episodes      []Episode  `json:"episodes,omitempty"`
+}
+
+type SeasonUsecase interface {
+	GetSeasons(ctx context.Context, movieID uint64) ([]Season, error)
+	GetSeason(ctx context.Context, movieID, number uint64) (Season, error)
+	Save(ctx context.Context, seasons []Season) error
+}
+
+type SeasonRepository interface {
+	GetByMovie(ctx context.Context, movieID uint64) ([]Season, error)
+	GetByNumber(ctx context.Context, movieID, number uint64) (Season, error)
+	GetEpisodes(ctx context.Context, movieID, season uint64) ([]Episode, error)
+	Save(ctx context.Context, s *Season) error
+	SaveEpisode(ctx context.Context, e *Episode) error
+}
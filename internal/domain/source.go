@@ -0,0 +1,27 @@
+package domain
+
+import "context"
+
+type MovieSource interface {
+	GetMovie(ctx context.Context, id uint64) (MovieDTO, error)
+	GetPerson(ctx context.Context, id uint64) (PersonDTO, error)
+	GetMovies(ctx context.Context, page, limit uint64, filter MovieFilter) (MoviePageDTO, error)
+	GetSeasons(ctx context.Context, movieID, page, limit uint64) (SeasonPageDTO, error)
+	GetMovieAwards(ctx context.Context, movieID, page, limit uint64) (AwardPageDTO, error)
+	GetPersonAwards(ctx context.Context, personID, page, limit uint64) (AwardPageDTO, error)
+	GetReviews(ctx context.Context, movieID, page, limit uint64) (ReviewPageDTO, error)
+	GetStudios(ctx context.Context, movieID, page, limit uint64) (StudioPageDTO, error)
+}
+
+type MovieFilter struct {
+	YearFrom  uint64
+	YearTo    uint64
+	Types     []string
+	MinRating float64
+	MinLength uint64
+	Countries []string
+}
+
+type RequestGate interface {
+	Wait(ctx context.Context) error
+}
@@ -0,0 +1,41 @@
+package domain
+
+import "context"
+
+const (
+	ProductionStudio   = "production"
+	DistributionStudio = "distribution"
+	EffectsStudio      = "special_effects"
+	DubbingStudio      = "dubbing"
+)
+
+// StudioTypes maps the studio types returned by kinopoisk to the stored ones.
+var StudioTypes = map[string]string{
+	"Производство":   ProductionStudio,
+	"Прокат":         DistributionStudio,
+	"Спецэффекты":    EffectsStudio,
+	"Студия дубляжа": DubbingStudio,
+}
+
+// Studio is a production company, distributor or other company credited on
+// movies. Movies is only filled when a single studio is requested.
+type Studio struct {
+	ID      uint64   `json:"id"`
+	Title   string   `json:"title"`
+	Type    string   `json:"type"`
+	SubType string   `json:"sub_type,omitempty"`
+	Movies  []uint64 `json:"movies,omitempty"`
+}
+
+type StudioUsecase interface {
+	GetByMovie(ctx context.Context, movieID uint64, studioType string) ([]Studio, error)
+	GetStudio(ctx context.Context, id uint64) (Studio, error)
+	Save(ctx context.Context, movieID uint64, studios []Studio) error
+}
+
+type StudioRepository interface {
+	GetByMovie(ctx context.Context, movieID uint64, studioType string) ([]Studio, error)
+	GetByID(ctx context.Context, id uint64) (Studio, error)
+	GetMovies(ctx context.Context, studioID uint64) ([]uint64, error)
+	Replace(ctx context.Context, movieID uint64, studios []Studio) error
+}
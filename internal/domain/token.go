@@ -0,0 +1,25 @@
+package domain
+
+import "context"
+
+const (
+	TokenUsageKey    = "token_usage"
+	TokenDisabledKey = "token_disabled"
+)
+
+// TokenUsage is the usage of a token today. A token without a daily quota is
+// Unlimited, its Remaining is always 0.
+type TokenUsage struct {
+	Token      string `json:"token"`
+	DailyQuota uint64 `json:"daily_quota"`
+	Used       uint64 `json:"used"`
+	Remaining  uint64 `json:"remaining"`
+	Unlimited  bool   `json:"unlimited"`
+	Disabled   bool   `json:"disabled"`
+}
+
+type TokenPool interface {
+	Acquire(ctx context.Context) (string, error)
+	Exhaust(ctx context.Context, token string) error
+	GetUsage(ctx context.Context) ([]TokenUsage, error)
+}
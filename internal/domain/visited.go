@@ -0,0 +1,18 @@
+package domain
+
+import "context"
+
+const (
+	SavedMovieFetchesKey  = "saved_movie_fetches"
+	SavedPersonFetchesKey = "saved_person_fetches"
+)
+
+type VisitedRepository interface {
+	IsVisited(ctx context.Context, kind, id uint64) (bool, error)
+	Visit(ctx context.Context, kind, id uint64) (bool, error)
+	Forget(ctx context.Context, kind, id uint64) error
+	// Reject keeps a visited item that was left out by the store scope.
+	Reject(ctx context.Context, kind, id uint64) error
+	// ForgetRejected forgets the rejected items, so they are fetched again.
+	ForgetRejected(ctx context.Context) (uint64, error)
+}
@@ -0,0 +1,38 @@
+package http
+
+import (
+	"Kinopoisk-Parser/internal/delivery/query"
+	"Kinopoisk-Parser/internal/domain"
+	"context"
+	"encoding/json"
+	"github.com/sirupsen/logrus"
+	"net/http"
+)
+
+type FailedHandler struct {
+	FUsecase domain.FailedUsecase
+}
+
+func NewFailedHandler(usecase domain.FailedUsecase) FailedHandler {
+	return FailedHandler{FUsecase: usecase}
+}
+
+func (h *FailedHandler) GetFailed(w http.ResponseWriter, r *http.Request) {
+	limit, offset := query.Page(r)
+
+	items, err := h.FUsecase.GetFailed(context.Background(), limit, offset)
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		logrus.Errorf("Error while get failed: %v", err)
+		return
+	}
+
+	itemsRaw, err := json.Marshal(items)
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		logrus.Errorf("Error while marshal: %v", err)
+		return
+	}
+
+	w.Write(itemsRaw)
+}
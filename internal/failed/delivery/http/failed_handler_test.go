@@ -0,0 +1,68 @@
+package http
+
+import (
+	"Kinopoisk-Parser/internal/domain"
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+type fakeFailed struct {
+	domain.FailedUsecase
+	limit, offset uint64
+	err           error
+}
+
+func (f *fakeFailed) GetFailed(ctx context.Context, limit, offset uint64) ([]domain.FailedItem, error) {
+	f.limit, f.offset = limit, offset
+
+	return []domain.FailedItem{{ID: 301, Kind: domain.MovieItem, Error: "bad status", Attempts: 2}}, f.err
+}
+
+func TestGetFailed(t *testing.T) {
+	usecase := &fakeFailed{}
+	handler := NewFailedHandler(usecase)
+
+	recorder := httptest.NewRecorder()
+	handler.GetFailed(recorder, httptest.NewRequest("GET", "/failed?limit=5&offset=10", nil))
+
+	if recorder.Code != http.StatusOK {
+		t.Fatalf("status = %d", recorder.Code)
+	}
+	if usecase.limit != 5 || usecase.offset != 10 {
+		t.Errorf("limit = %d, offset = %d", usecase.limit, usecase.offset)
+	}
+
+	var items []domain.FailedItem
+	if err := json.Unmarshal(recorder.Body.Bytes(), &items); err != nil {
+		t.Fatalf("unmarshal: %v", err)
+	}
+	if len(items) != 1 || items[0].ID != 301 || items[0].Attempts != 2 {
+		t.Errorf("items = %+v", items)
+	}
+}
+
+func TestGetFailedDefaultPage(t *testing.T) {
+	usecase := &fakeFailed{}
+	handler := NewFailedHandler(usecase)
+
+	handler.GetFailed(httptest.NewRecorder(), httptest.NewRequest("GET", "/failed?limit=many", nil))
+
+	if usecase.limit != 20 || usecase.offset != 0 {
+		t.Errorf("limit = %d, offset = %d", usecase.limit, usecase.offset)
+	}
+}
+
+func TestGetFailedError(t *testing.T) {
+	handler := NewFailedHandler(&fakeFailed{err: errors.New("connection refused")})
+
+	recorder := httptest.NewRecorder()
+	handler.GetFailed(recorder, httptest.NewRequest("GET", "/failed", nil))
+
+	if recorder.Code != http.StatusInternalServerError {
+		t.Errorf("status = %d", recorder.Code)
+	}
+}
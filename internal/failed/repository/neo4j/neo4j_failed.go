@@ -0,0 +1,82 @@
+package neo4jFailedRepo
+
+import (
+	"Kinopoisk-Parser/internal/domain"
+	"context"
+	"github.com/neo4j/neo4j-go-driver/v5/neo4j"
+	"github.com/sirupsen/logrus"
+	"time"
+)
+
+type Neo4jFailedRepo struct {
+	Driver neo4j.DriverWithContext
+}
+
+func New(driver neo4j.DriverWithContext) domain.FailedRepository {
+	return &Neo4jFailedRepo{Driver: driver}
+}
+
+func (n Neo4jFailedRepo) GetFailed(ctx context.Context, limit, offset uint64) ([]domain.FailedItem, error) {
+	result, err := neo4j.ExecuteQuery(ctx, n.Driver,
+		"MATCH (f:FailedItem) RETURN f ORDER BY f.FailedAt SKIP $offset LIMIT $limit",
+		map[string]any{
+			"limit":  limit,
+			"offset": offset,
+		}, neo4j.EagerResultTransformer)
+	if err != nil {
+		logrus.Error(err)
+		return nil, err
+	}
+
+	resultItems := make([]domain.FailedItem, 0)
+
+	for _, record := range result.Records {
+		itemNode, _, err := neo4j.GetRecordValue[neo4j.Node](record, "f")
+		if err != nil {
+			return resultItems, err
+		}
+
+		item := domain.FailedItem{}
+
+		id, _ := neo4j.GetProperty[int64](itemNode, "ID")
+		item.ID = uint64(id)
+
+		kind, _ := neo4j.GetProperty[int64](itemNode, "Kind")
+		item.Kind = uint64(kind)
+
+		item.Error, _ = neo4j.GetProperty[string](itemNode, "Error")
+
+		attempts, _ := neo4j.GetProperty[int64](itemNode, "Attempts")
+		item.Attempts = uint64(attempts)
+
+		item.FailedAt, _ = neo4j.GetProperty[time.Time](itemNode, "FailedAt")
+
+		resultItems = append(resultItems, item)
+	}
+
+	return resultItems, nil
+}
+
+func (n Neo4jFailedRepo) Add(ctx context.Context, kind, id uint64, reason string) error {
+	_, err := neo4j.ExecuteQuery(ctx, n.Driver,
+		"MERGE (f:FailedItem {Kind: $kind, ID: $id}) ON CREATE SET f.Attempts = 0 "+
+			"SET f.Attempts = f.Attempts + 1, f.Error = $error, f.FailedAt = datetime()",
+		map[string]any{
+			"kind":  kind,
+			"id":    id,
+			"error": reason,
+		}, neo4j.EagerResultTransformer)
+
+	return err
+}
+
+func (n Neo4jFailedRepo) Delete(ctx context.Context, kind, id uint64) error {
+	_, err := neo4j.ExecuteQuery(ctx, n.Driver,
+		"MATCH (f:FailedItem {Kind: $kind, ID: $id}) DELETE f",
+		map[string]any{
+			"kind": kind,
+			"id":   id,
+		}, neo4j.EagerResultTransformer)
+
+	return err
+}
@@ -0,0 +1,72 @@
+package postgresql
+
+import (
+	"Kinopoisk-Parser/internal/domain"
+	"context"
+	"database/sql"
+	"github.com/sirupsen/logrus"
+)
+
+type pgFailedRepo struct {
+	Conn *sql.DB
+}
+
+func New(conn *sql.DB) domain.FailedRepository {
+	return &pgFailedRepo{Conn: conn}
+}
+
+func (p pgFailedRepo) GetFailed(ctx context.Context, limit, offset uint64) ([]domain.FailedItem, error) {
+	query := `SELECT item_id, item_kind, error, attempts, failed_at FROM failed_items
+			 ORDER BY failed_at LIMIT $1 OFFSET $2;`
+
+	rows, err := p.Conn.QueryContext(ctx, query, limit, offset)
+	if err != nil {
+		logrus.Errorf("Repo error: %v", err)
+		return nil, err
+	}
+
+	defer func() {
+		err := rows.Close()
+		if err != nil {
+			logrus.Errorf("Repo closing error: %v", err)
+		}
+	}()
+
+	result := make([]domain.FailedItem, 0)
+	for rows.Next() {
+		tmpItem := domain.FailedItem{}
+		err = rows.Scan(
+			&tmpItem.ID,
+			&tmpItem.Kind,
+			&tmpItem.Error,
+			&tmpItem.Attempts,
+			&tmpItem.FailedAt)
+
+		if err != nil {
+			logrus.Errorf("Repo error: %v", err)
+			return nil, err
+		}
+
+		result = append(result, tmpItem)
+	}
+
+	return result, err
+}
+
+func (p pgFailedRepo) Add(ctx context.Context, kind, id uint64, reason string) error {
+	query := `INSERT into failed_items(item_kind, item_id, error) VALUES ($1, $2, $3)
+			 ON CONFLICT (item_kind, item_id) DO UPDATE SET error = excluded.error,
+			 attempts = failed_items.attempts + 1, failed_at = now();`
+
+	_, err := p.Conn.ExecContext(ctx, query, kind, id, reason)
+
+	return err
+}
+
+func (p pgFailedRepo) Delete(ctx context.Context, kind, id uint64) error {
+	query := `DELETE FROM failed_items WHERE item_kind = $1 AND item_id = $2;`
+
+	_, err := p.Conn.ExecContext(ctx, query, kind, id)
+
+	return err
+}
@@ -0,0 +1,61 @@
+package usecase
+
+import (
+	"Kinopoisk-Parser/internal/domain"
+	"context"
+	"fmt"
+	"github.com/sirupsen/logrus"
+	"time"
+)
+
+const replayPageSize uint64 = 100
+
+type failedUsecase struct {
+	failedRepo     domain.FailedRepository
+	frontierRepo   domain.FrontierRepository
+	contextTimeout time.Duration
+}
+
+func NewFailedUsecase(f domain.FailedRepository, fr domain.FrontierRepository, timeout time.Duration) domain.FailedUsecase {
+	return &failedUsecase{
+		failedRepo:     f,
+		frontierRepo:   fr,
+		contextTimeout: timeout,
+	}
+}
+
+func (u *failedUsecase) GetFailed(ctx context.Context, limit, offset uint64) ([]domain.FailedItem, error) {
+	ctx, cancel := context.WithTimeout(ctx, u.contextTimeout)
+	defer cancel()
+
+	items, err := u.failedRepo.GetFailed(ctx, limit, offset)
+	if err != nil {
+		logrus.Errorf("Usecase: %v", err)
+		return nil, err
+	}
+
+	return items, nil
+}
+
+func (u *failedUsecase) Replay(ctx context.Context) (uint64, error) {
+	var replayed uint64
+	for offset := uint64(0); ; offset += replayPageSize {
+		items, err := u.GetFailed(ctx, replayPageSize, offset)
+		if err != nil {
+			return replayed, fmt.Errorf("usecase: %v", err)
+		}
+
+		for _, item := range items {
+			err = u.frontierRepo.Push(ctx, domain.FrontierItem{ID: item.ID, Kind: item.Kind})
+			if err != nil {
+				logrus.Errorf("Usecase: %v", err)
+				return replayed, fmt.Errorf("usecase: %v", err)
+			}
+			replayed += 1
+		}
+
+		if uint64(len(items)) < replayPageSize {
+			return replayed, nil
+		}
+	}
+}
@@ -0,0 +1,88 @@
+package usecase
+
+import (
+	"Kinopoisk-Parser/internal/domain"
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+type fakeFailedRepo struct {
+	domain.FailedRepository
+	items []domain.FailedItem
+}
+
+func (f fakeFailedRepo) GetFailed(ctx context.Context, limit, offset uint64) ([]domain.FailedItem, error) {
+	if offset >= uint64(len(f.items)) {
+		return nil, nil
+	}
+
+	end := offset + limit
+	if end > uint64(len(f.items)) {
+		end = uint64(len(f.items))
+	}
+
+	return f.items[offset:end], nil
+}
+
+type fakeFrontier struct {
+	domain.FrontierRepository
+	pushed []domain.FrontierItem
+	err    error
+}
+
+func (f *fakeFrontier) Push(ctx context.Context, item domain.FrontierItem) error {
+	if f.err != nil {
+		return f.err
+	}
+	f.pushed = append(f.pushed, item)
+
+	return nil
+}
+
+func failedItems(count int) []domain.FailedItem {
+	items := make([]domain.FailedItem, 0, count)
+	for i := 0; i < count; i++ {
+		items = append(items, domain.FailedItem{ID: uint64(i + 1), Kind: domain.MovieItem})
+	}
+
+	return items
+}
+
+func TestReplay(t *testing.T) {
+	frontier := &fakeFrontier{}
+	u := NewFailedUsecase(fakeFailedRepo{items: failedItems(250)}, frontier, time.Second)
+
+	replayed, err := u.Replay(context.Background())
+	if err != nil {
+		t.Fatalf("replay: %v", err)
+	}
+
+	if replayed != 250 || len(frontier.pushed) != 250 {
+		t.Errorf("replayed = %d, pushed = %d", replayed, len(frontier.pushed))
+	}
+	if last := frontier.pushed[249]; last.ID != 250 || last.Kind != domain.MovieItem {
+		t.Errorf("last pushed item = %+v", last)
+	}
+}
+
+func TestReplayFullPage(t *testing.T) {
+	frontier := &fakeFrontier{}
+	u := NewFailedUsecase(fakeFailedRepo{items: failedItems(int(replayPageSize))}, frontier, time.Second)
+
+	replayed, err := u.Replay(context.Background())
+	if err != nil || replayed != replayPageSize {
+		t.Errorf("replayed = %d, err = %v", replayed, err)
+	}
+}
+
+func TestReplayPushError(t *testing.T) {
+	frontier := &fakeFrontier{err: errors.New("connection refused")}
+	u := NewFailedUsecase(fakeFailedRepo{items: failedItems(3)}, frontier, time.Second)
+
+	replayed, err := u.Replay(context.Background())
+	if err == nil || replayed != 0 {
+		t.Errorf("replayed = %d, err = %v", replayed, err)
+	}
+}
@@ -0,0 +1,220 @@
+package neo4jFrontierRepo
+
+import (
+	"Kinopoisk-Parser/internal/domain"
+	"context"
+	"fmt"
+	"github.com/neo4j/neo4j-go-driver/v5/neo4j"
+	"github.com/sirupsen/logrus"
+	"time"
+)
+
+var popOrder = map[string]string{
+	domain.BFSStrategy:    "f.Seq",
+	domain.DFSStrategy:    "f.Seq DESC",
+	domain.RatingStrategy: fmt.Sprintf("f.Kind = %d, f.Priority DESC, f.Seq", domain.PersonItem),
+	domain.VotesStrategy:  fmt.Sprintf("f.Kind = %d, f.Priority DESC, f.Seq", domain.PersonItem),
+}
+
+type Neo4jFrontierRepo struct {
+	Driver neo4j.DriverWithContext
+}
+
+func New(driver neo4j.DriverWithContext) domain.FrontierRepository {
+	return &Neo4jFrontierRepo{Driver: driver}
+}
+
+func (n Neo4jFrontierRepo) Push(ctx context.Context, item domain.FrontierItem) error {
+	return n.push(ctx, item, "")
+}
+
+// Release hands a leased item back to the queue, the item is stored again if
+// it was not popped from the frontier.
+func (n Neo4jFrontierRepo) Release(ctx context.Context, item domain.FrontierItem) error {
+	return n.push(ctx, item, " REMOVE f.ClaimedAt")
+}
+
+func (n Neo4jFrontierRepo) push(ctx context.Context, item domain.FrontierItem, onMatch string) error {
+	_, err := neo4j.ExecuteQuery(ctx, n.Driver,
+		"MERGE (s:CrawlState {Key: 'frontier_seq'}) ON CREATE SET s.Value = 0 "+
+			"SET s.Value = s.Value + 1 "+
+			"MERGE (f:FrontierItem {Kind: $kind, ID: $id}) "+
+			"ON CREATE SET f.Seq = s.Value, f.Priority = $priority, f.Depth = $depth, f.MaxDepth = $maxDepth "+
+			"ON MATCH SET f.Priority = CASE WHEN $kind = $personKind THEN f.Priority + $priority "+
+			"WHEN f.Priority > $priority THEN f.Priority ELSE $priority END, "+
+			"f.Depth = CASE WHEN f.Depth < $depth THEN f.Depth ELSE $depth END, "+
+			"f.MaxDepth = CASE WHEN f.MaxDepth = 0 OR $maxDepth = 0 THEN 0 "+
+			"WHEN f.MaxDepth > $maxDepth THEN f.MaxDepth ELSE $maxDepth END"+onMatch,
+		map[string]any{
+			"kind":       item.Kind,
+			"id":         item.ID,
+			"priority":   item.Priority,
+			"depth":      item.Depth,
+			"maxDepth":   item.MaxDepth,
+			"personKind": domain.PersonItem,
+		}, neo4j.EagerResultTransformer)
+
+	return err
+}
+
+// popAttempts bounds how many times Pop looks for another item when a
+// concurrent Pop leased the selected one first.
+const popAttempts = 5
+
+// Pop leases the next unclaimed item, it stays in the frontier until Done.
+// The item is write-locked with f._lock before its lease is checked again,
+// so two concurrent Pops cannot lease the same item.
+func (n Neo4jFrontierRepo) Pop(ctx context.Context, strategy string) (domain.FrontierItem, error) {
+	order, ok := popOrder[strategy]
+	if !ok {
+		order = popOrder[domain.BFSStrategy]
+	}
+
+	for attempt := 0; attempt < popAttempts; attempt++ {
+		result, err := neo4j.ExecuteQuery(ctx, n.Driver,
+			"MATCH (f:FrontierItem) WHERE f.ClaimedAt IS NULL WITH f ORDER BY "+order+" LIMIT 1 "+
+				"SET f._lock = true "+
+				"WITH f, f.ClaimedAt IS NULL AS free "+
+				"SET f.ClaimedAt = CASE WHEN free THEN datetime() ELSE f.ClaimedAt END "+
+				"REMOVE f._lock "+
+				"RETURN free, f.Kind AS kind, f.ID AS id, f.Priority AS priority, f.Depth AS depth, f.MaxDepth AS maxDepth",
+			map[string]any{}, neo4j.EagerResultTransformer)
+		if err != nil {
+			logrus.Error(err)
+			return domain.FrontierItem{}, err
+		}
+
+		if len(result.Records) == 0 {
+			return domain.FrontierItem{}, domain.FrontierEmpty
+		}
+
+		free, _, _ := neo4j.GetRecordValue[bool](result.Records[0], "free")
+		if !free {
+			continue
+		}
+
+		item := domain.FrontierItem{}
+
+		kind, _, _ := neo4j.GetRecordValue[int64](result.Records[0], "kind")
+		item.Kind = uint64(kind)
+
+		id, _, _ := neo4j.GetRecordValue[int64](result.Records[0], "id")
+		item.ID = uint64(id)
+
+		item.Priority, _, _ = neo4j.GetRecordValue[float64](result.Records[0], "priority")
+
+		depth, _, _ := neo4j.GetRecordValue[int64](result.Records[0], "depth")
+		item.Depth = uint64(depth)
+
+		maxDepth, _, _ := neo4j.GetRecordValue[int64](result.Records[0], "maxDepth")
+		item.MaxDepth = uint64(maxDepth)
+
+		return item, nil
+	}
+
+	return domain.FrontierItem{}, fmt.Errorf("frontier items leased concurrently %d times in a row", popAttempts)
+}
+
+func (n Neo4jFrontierRepo) Done(ctx context.Context, kind, id uint64) error {
+	_, err := neo4j.ExecuteQuery(ctx, n.Driver,
+		"MATCH (f:FrontierItem {Kind: $kind, ID: $id}) DELETE f",
+		map[string]any{
+			"kind": kind,
+			"id":   id,
+		}, neo4j.EagerResultTransformer)
+
+	return err
+}
+
+// ReleaseClaimed returns to the queue the items leased before the given time,
+// which were left by a crawl that stopped without finishing them.
+func (n Neo4jFrontierRepo) ReleaseClaimed(ctx context.Context, before time.Time) (uint64, error) {
+	result, err := neo4j.ExecuteQuery(ctx, n.Driver,
+		"MATCH (f:FrontierItem) WHERE f.ClaimedAt < $before REMOVE f.ClaimedAt RETURN count(f) AS total",
+		map[string]any{
+			"before": before,
+		}, neo4j.EagerResultTransformer)
+	if err != nil {
+		logrus.Error(err)
+		return 0, err
+	}
+
+	total, _, err := neo4j.GetRecordValue[int64](result.Records[0], "total")
+	if err != nil {
+		return 0, err
+	}
+
+	return uint64(total), nil
+}
+
+func (n Neo4jFrontierRepo) Len(ctx context.Context) (uint64, error) {
+	result, err := neo4j.ExecuteQuery(ctx, n.Driver,
+		"MATCH (f:FrontierItem) WHERE f.ClaimedAt IS NULL RETURN count(f) AS total",
+		map[string]any{}, neo4j.EagerResultTransformer)
+	if err != nil {
+		logrus.Error(err)
+		return 0, err
+	}
+
+	total, _, err := neo4j.GetRecordValue[int64](result.Records[0], "total")
+	if err != nil {
+		return 0, err
+	}
+
+	return uint64(total), nil
+}
+
+func (n Neo4jFrontierRepo) GetState(ctx context.Context, key string) (uint64, error) {
+	result, err := neo4j.ExecuteQuery(ctx, n.Driver,
+		"MATCH (s:CrawlState {Key: $key}) RETURN s.Value AS value",
+		map[string]any{
+			"key": key,
+		}, neo4j.EagerResultTransformer)
+	if err != nil {
+		logrus.Error(err)
+		return 0, err
+	}
+
+	if len(result.Records) == 0 {
+		return 0, domain.StateNotFound
+	}
+
+	value, _, err := neo4j.GetRecordValue[int64](result.Records[0], "value")
+	if err != nil {
+		return 0, domain.StateNotFound
+	}
+
+	return uint64(value), nil
+}
+
+func (n Neo4jFrontierRepo) SetState(ctx context.Context, key string, value uint64) error {
+	_, err := neo4j.ExecuteQuery(ctx, n.Driver,
+		"MERGE (s:CrawlState {Key: $key}) SET s.Value = $value",
+		map[string]any{
+			"key":   key,
+			"value": value,
+		}, neo4j.EagerResultTransformer)
+
+	return err
+}
+
+func (n Neo4jFrontierRepo) IncState(ctx context.Context, key string, delta uint64) (uint64, error) {
+	result, err := neo4j.ExecuteQuery(ctx, n.Driver,
+		"MERGE (s:CrawlState {Key: $key}) ON CREATE SET s.Value = 0 "+
+			"SET s.Value = s.Value + $delta RETURN s.Value AS value",
+		map[string]any{
+			"key":   key,
+			"delta": delta,
+		}, neo4j.EagerResultTransformer)
+	if err != nil {
+		logrus.Error(err)
+		return 0, err
+	}
+
+	value, _, err := neo4j.GetRecordValue[int64](result.Records[0], "value")
+	if err != nil {
+		return 0, err
+	}
+
+	return uint64(value), nil
+}
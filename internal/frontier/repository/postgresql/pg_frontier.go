@@ -0,0 +1,177 @@
+package postgresql
+
+import (
+	"Kinopoisk-Parser/internal/domain"
+	"context"
+	"database/sql"
+	"fmt"
+	"github.com/sirupsen/logrus"
+	"time"
+)
+
+var popOrder = map[string]string{
+	domain.BFSStrategy:    `id`,
+	domain.DFSStrategy:    `id DESC`,
+	domain.RatingStrategy: fmt.Sprintf(`item_kind = %d, priority DESC, id`, domain.PersonItem),
+	domain.VotesStrategy:  fmt.Sprintf(`item_kind = %d, priority DESC, id`, domain.PersonItem),
+}
+
+type pgFrontierRepo struct {
+	Conn *sql.DB
+}
+
+func New(conn *sql.DB) domain.FrontierRepository {
+	return &pgFrontierRepo{Conn: conn}
+}
+
+func (p pgFrontierRepo) Push(ctx context.Context, item domain.FrontierItem) error {
+	return p.push(ctx, item, ``)
+}
+
+// Release hands a leased item back to the queue, the item is stored again if
+// it was not popped from the frontier.
+func (p pgFrontierRepo) Release(ctx context.Context, item domain.FrontierItem) error {
+	return p.push(ctx, item, `, claimed_at = NULL`)
+}
+
+func (p pgFrontierRepo) push(ctx context.Context, item domain.FrontierItem, onConflict string) error {
+	query := `INSERT into crawl_frontier(item_kind, item_id, priority, depth, max_depth) VALUES ($1, $2, $3, $4, $5)
+			 ON CONFLICT (item_kind, item_id) DO UPDATE SET priority = CASE
+				WHEN crawl_frontier.item_kind = $6 THEN crawl_frontier.priority + excluded.priority
+				ELSE GREATEST(crawl_frontier.priority, excluded.priority)
+			 END, depth = LEAST(crawl_frontier.depth, excluded.depth), max_depth = CASE
+				WHEN crawl_frontier.max_depth = 0 OR excluded.max_depth = 0 THEN 0
+				ELSE GREATEST(crawl_frontier.max_depth, excluded.max_depth)
+			 END` + onConflict + `;`
+
+	_, err := p.Conn.ExecContext(ctx, query, item.Kind, item.ID, item.Priority, item.Depth, item.MaxDepth,
+		domain.PersonItem)
+
+	return err
+}
+
+// Pop leases the next unclaimed item, it stays in the frontier until Done.
+func (p pgFrontierRepo) Pop(ctx context.Context, strategy string) (domain.FrontierItem, error) {
+	order, ok := popOrder[strategy]
+	if !ok {
+		order = popOrder[domain.BFSStrategy]
+	}
+
+	query := `UPDATE crawl_frontier SET claimed_at = now() WHERE id = (
+				SELECT id FROM crawl_frontier WHERE claimed_at IS NULL
+				ORDER BY ` + order + ` LIMIT 1 FOR UPDATE SKIP LOCKED
+			 ) RETURNING item_kind, item_id, priority, depth, max_depth;`
+
+	rows, err := p.Conn.QueryContext(ctx, query)
+	if err != nil {
+		logrus.Errorf("Repo error: %v", err)
+		return domain.FrontierItem{}, err
+	}
+
+	defer func() {
+		err := rows.Close()
+		if err != nil {
+			logrus.Errorf("Repo closing error: %v", err)
+		}
+	}()
+
+	item := domain.FrontierItem{}
+	if rows.Next() {
+		err = rows.Scan(
+			&item.Kind,
+			&item.ID,
+			&item.Priority,
+			&item.Depth,
+			&item.MaxDepth)
+	} else {
+		err = domain.FrontierEmpty
+	}
+
+	if err != nil && err != domain.FrontierEmpty {
+		logrus.Errorf("Repo error: %v", err)
+		return domain.FrontierItem{}, err
+	}
+
+	return item, err
+}
+
+func (p pgFrontierRepo) Done(ctx context.Context, kind, id uint64) error {
+	query := `DELETE FROM crawl_frontier WHERE item_kind = $1 AND item_id = $2;`
+
+	_, err := p.Conn.ExecContext(ctx, query, kind, id)
+
+	return err
+}
+
+// ReleaseClaimed returns to the queue the items leased before the given time,
+// which were left by a crawl that stopped without finishing them.
+func (p pgFrontierRepo) ReleaseClaimed(ctx context.Context, before time.Time) (uint64, error) {
+	query := `UPDATE crawl_frontier SET claimed_at = NULL WHERE claimed_at < $1;`
+
+	res, err := p.Conn.ExecContext(ctx, query, before)
+	if err != nil {
+		logrus.Errorf("Repo error: %v", err)
+		return 0, err
+	}
+
+	released, err := res.RowsAffected()
+	if err != nil {
+		logrus.Errorf("Repo error: %v", err)
+		return 0, err
+	}
+
+	return uint64(released), nil
+}
+
+func (p pgFrontierRepo) Len(ctx context.Context) (uint64, error) {
+	query := `SELECT count(*) FROM crawl_frontier WHERE claimed_at IS NULL;`
+
+	var result uint64
+	err := p.Conn.QueryRowContext(ctx, query).Scan(&result)
+	if err != nil {
+		logrus.Errorf("Repo error: %v", err)
+		return 0, err
+	}
+
+	return result, nil
+}
+
+func (p pgFrontierRepo) GetState(ctx context.Context, key string) (uint64, error) {
+	query := `SELECT state_value FROM crawl_state WHERE state_key = $1;`
+
+	var result uint64
+	err := p.Conn.QueryRowContext(ctx, query, key).Scan(&result)
+	switch err {
+	case nil:
+		return result, nil
+	case sql.ErrNoRows:
+		return 0, domain.StateNotFound
+	default:
+		logrus.Errorf("Repo error: %v", err)
+		return 0, err
+	}
+}
+
+func (p pgFrontierRepo) SetState(ctx context.Context, key string, value uint64) error {
+	query := `INSERT into crawl_state(state_key, state_value) VALUES ($1, $2)
+			 ON CONFLICT (state_key) DO UPDATE SET state_value = excluded.state_value;`
+
+	_, err := p.Conn.ExecContext(ctx, query, key, value)
+
+	return err
+}
+
+func (p pgFrontierRepo) IncState(ctx context.Context, key string, delta uint64) (uint64, error) {
+	query := `INSERT into crawl_state(state_key, state_value) VALUES ($1, $2)
+			 ON CONFLICT (state_key) DO UPDATE SET state_value = crawl_state.state_value + excluded.state_value
+			 RETURNING state_value;`
+
+	var result uint64
+	err := p.Conn.QueryRowContext(ctx, query, key, delta).Scan(&result)
+	if err != nil {
+		logrus.Errorf("Repo error: %v", err)
+		return 0, err
+	}
+
+	return result, nil
+}
@@ -5,6 +5,7 @@ import (
 	"context"
 	"github.com/neo4j/neo4j-go-driver/v5/neo4j"
 	"github.com/sirupsen/logrus"
+	"time"
 )
 
 type Neo4jMovieRepo struct {
@@ -17,7 +18,7 @@ func New(driver neo4j.DriverWithContext) domain.MovieRepository {
 
 func (n Neo4jMovieRepo) GetByID(ctx context.Context, id uint64) (domain.MovieBaseInfo, error) {
 	result, err := neo4j.ExecuteQuery(ctx, n.Driver,
-		"MATCH (m:Movie {ID: $id}) return m",
+		"MATCH (m:Movie {ID: $id}) WHERE m.Title IS NOT NULL return m",
 		map[string]any{
 			"id": id,
 		}, neo4j.EagerResultTransformer)
@@ -26,31 +27,16 @@ func (n Neo4jMovieRepo) GetByID(ctx context.Context, id uint64) (domain.MovieBas
 		return domain.MovieBaseInfo{}, err
 	}
 
+	if len(result.Records) == 0 {
+		return domain.MovieBaseInfo{}, domain.MovieNotFound
+	}
+
 	itemNode, _, err := neo4j.GetRecordValue[neo4j.Node](result.Records[0], "m")
 	if err != nil {
 		return domain.MovieBaseInfo{}, domain.MovieNotFound
 	}
 
-	movie := domain.MovieBaseInfo{ID: id}
-
-	movie.Title, _ = neo4j.GetProperty[string](itemNode, "Title")
-	movie.Tagline, _ = neo4j.GetProperty[string](itemNode, "Tagline")
-
-	year, _ := neo4j.GetProperty[int64](itemNode, "Year")
-	movie.Year = uint64(year)
-
-	budget, _ := neo4j.GetProperty[int64](itemNode, "Budget")
-	movie.Budget = uint64(budget)
-
-	gross, _ := neo4j.GetProperty[int64](itemNode, "Gross")
-	movie.Gross = uint64(gross)
-
-	duration, _ := neo4j.GetProperty[int64](itemNode, "Duration")
-	movie.Duration = uint64(duration)
-
-	movie.Rating, _ = neo4j.GetProperty[float64](itemNode, "Rating")
-
-	return movie, nil
+	return movieFromNode(itemNode), nil
 }
 
 func (n Neo4jMovieRepo) GetByTitle(ctx context.Context, title string) (domain.MovieBaseInfo, error) {
@@ -64,38 +50,21 @@ func (n Neo4jMovieRepo) GetByTitle(ctx context.Context, title string) (domain.Mo
 		return domain.MovieBaseInfo{}, err
 	}
 
+	if len(result.Records) == 0 {
+		return domain.MovieBaseInfo{}, domain.MovieNotFound
+	}
+
 	itemNode, _, err := neo4j.GetRecordValue[neo4j.Node](result.Records[0], "m")
 	if err != nil {
 		return domain.MovieBaseInfo{}, domain.MovieNotFound
 	}
 
-	movie := domain.MovieBaseInfo{Title: title}
-
-	id, _ := neo4j.GetProperty[int64](itemNode, "ID")
-	movie.ID = uint64(id)
-
-	movie.Tagline, _ = neo4j.GetProperty[string](itemNode, "Tagline")
-
-	year, _ := neo4j.GetProperty[int64](itemNode, "Year")
-	movie.Year = uint64(year)
-
-	budget, _ := neo4j.GetProperty[int64](itemNode, "Budget")
-	movie.Budget = uint64(budget)
-
-	gross, _ := neo4j.GetProperty[int64](itemNode, "Gross")
-	movie.Gross = uint64(gross)
-
-	duration, _ := neo4j.GetProperty[int64](itemNode, "Duration")
-	movie.Duration = uint64(duration)
-
-	movie.Rating, _ = neo4j.GetProperty[float64](itemNode, "Rating")
-
-	return movie, nil
+	return movieFromNode(itemNode), nil
 }
 
 func (n Neo4jMovieRepo) GetMovies(ctx context.Context, limit, offset uint64) ([]domain.MovieBaseInfo, error) {
 	result, err := neo4j.ExecuteQuery(ctx, n.Driver,
-		"MATCH (m:Movie) return m",
+		"MATCH (m:Movie) WHERE m.Title IS NOT NULL return m",
 		map[string]any{}, neo4j.EagerResultTransformer)
 	if err != nil {
 		logrus.Error(err)
@@ -111,50 +80,195 @@ func (n Neo4jMovieRepo) GetMovies(ctx context.Context, limit, offset uint64) ([]
 			return resultMovies, err
 		}
 
-		movie := domain.MovieBaseInfo{}
+		resultMovies = append(resultMovies, movieFromNode(itemNode))
+	}
+
+	return resultMovies, nil
+}
+
+func movieFromNode(itemNode neo4j.Node) domain.MovieBaseInfo {
+	movie := domain.MovieBaseInfo{}
+
+	id, _ := neo4j.GetProperty[int64](itemNode, "ID")
+	movie.ID = uint64(id)
+
+	movie.Title, _ = neo4j.GetProperty[string](itemNode, "Title")
+	movie.AlternativeName, _ = neo4j.GetProperty[string](itemNode, "AlternativeName")
+	movie.EnName, _ = neo4j.GetProperty[string](itemNode, "EnName")
+	movie.Type, _ = neo4j.GetProperty[string](itemNode, "Type")
+	movie.IsSeries, _ = neo4j.GetProperty[bool](itemNode, "IsSeries")
+	movie.Status, _ = neo4j.GetProperty[string](itemNode, "Status")
+	movie.Tagline, _ = neo4j.GetProperty[string](itemNode, "Tagline")
+	movie.Description, _ = neo4j.GetProperty[string](itemNode, "Description")
+	movie.ShortDescription, _ = neo4j.GetProperty[string](itemNode, "ShortDescription")
+
+	year, _ := neo4j.GetProperty[int64](itemNode, "Year")
+	movie.Year = uint64(year)
+
+	duration, _ := neo4j.GetProperty[int64](itemNode, "Duration")
+	movie.Duration = uint64(duration)
+
+	ageRating, _ := neo4j.GetProperty[int64](itemNode, "AgeRating")
+	movie.AgeRating = uint64(ageRating)
 
-		id, _ := neo4j.GetProperty[int64](itemNode, "ID")
-		movie.ID = uint64(id)
+	movie.Rating, _ = neo4j.GetProperty[float64](itemNode, "Rating")
+	movie.Ratings.Imdb = movie.Rating
+	movie.Ratings.Kp, _ = neo4j.GetProperty[float64](itemNode, "RatingKp")
+	movie.Ratings.Tmdb, _ = neo4j.GetProperty[float64](itemNode, "RatingTmdb")
+	movie.Ratings.FilmCritics, _ = neo4j.GetProperty[float64](itemNode, "RatingFilmCritics")
+	movie.Ratings.RussianFilmCritics, _ = neo4j.GetProperty[float64](itemNode, "RatingRussianFilmCritics")
+	movie.Ratings.Await, _ = neo4j.GetProperty[float64](itemNode, "RatingAwait")
+
+	reviewCount, _ := neo4j.GetProperty[int64](itemNode, "ReviewCount")
+	movie.ReviewInfo.Count = uint64(reviewCount)
+	reviewPositive, _ := neo4j.GetProperty[int64](itemNode, "ReviewPositive")
+	movie.ReviewInfo.Positive = uint64(reviewPositive)
+	movie.ReviewInfo.Percentage, _ = neo4j.GetProperty[string](itemNode, "ReviewPercentage")
 
-		movie.Title, _ = neo4j.GetProperty[string](itemNode, "Title")
-		movie.Tagline, _ = neo4j.GetProperty[string](itemNode, "Tagline")
+	budget, _ := neo4j.GetProperty[int64](itemNode, "Budget")
+	movie.Budget = uint64(budget)
+	movie.BudgetCurrency, _ = neo4j.GetProperty[string](itemNode, "BudgetCurrency")
 
-		year, _ := neo4j.GetProperty[int64](itemNode, "Year")
-		movie.Year = uint64(year)
+	gross, _ := neo4j.GetProperty[int64](itemNode, "Gross")
+	movie.Gross = uint64(gross)
+	movie.GrossCurrency, _ = neo4j.GetProperty[string](itemNode, "GrossCurrency")
 
-		budget, _ := neo4j.GetProperty[int64](itemNode, "Budget")
-		movie.Budget = uint64(budget)
+	grossUsa, _ := neo4j.GetProperty[int64](itemNode, "GrossUsa")
+	movie.GrossUsa = uint64(grossUsa)
+	movie.GrossUsaCurrency, _ = neo4j.GetProperty[string](itemNode, "GrossUsaCurrency")
 
-		gross, _ := neo4j.GetProperty[int64](itemNode, "Gross")
-		movie.Gross = uint64(gross)
+	grossRus, _ := neo4j.GetProperty[int64](itemNode, "GrossRus")
+	movie.GrossRus = uint64(grossRus)
+	movie.GrossRusCurrency, _ = neo4j.GetProperty[string](itemNode, "GrossRusCurrency")
 
-		duration, _ := neo4j.GetProperty[int64](itemNode, "Duration")
-		movie.Duration = uint64(duration)
+	movie.Genres = stringList(itemNode, "Genres")
+	movie.Countries = stringList(itemNode, "Countries")
 
-		movie.Rating, _ = neo4j.GetProperty[float64](itemNode, "Rating")
+	movie.FetchedAt, _ = neo4j.GetProperty[time.Time](itemNode, "FetchedAt")
 
-		resultMovies = append(resultMovies, movie)
+	return movie
+}
+
+func stringList(itemNode neo4j.Node, key string) []string {
+	values, _ := neo4j.GetProperty[[]any](itemNode, key)
+
+	result := make([]string, 0, len(values))
+	for _, value := range values {
+		if str, ok := value.(string); ok {
+			result = append(result, str)
+		}
 	}
 
-	return resultMovies, nil
+	return result
+}
+
+func (n Neo4jMovieRepo) Count(ctx context.Context) (uint64, error) {
+	result, err := neo4j.ExecuteQuery(ctx, n.Driver,
+		"MATCH (m:Movie) WHERE m.Title IS NOT NULL RETURN count(m) AS total",
+		map[string]any{}, neo4j.EagerResultTransformer)
+	if err != nil {
+		logrus.Error(err)
+		return 0, err
+	}
+
+	total, _, err := neo4j.GetRecordValue[int64](result.Records[0], "total")
+	if err != nil {
+		return 0, err
+	}
+
+	return uint64(total), nil
 }
 
+// Add merges on the ID, because a movie may already have a node without
+// properties created as the target of a relation to another movie.
 func (n Neo4jMovieRepo) Add(ctx context.Context, m *domain.MovieBaseInfo) error {
 	_, err := neo4j.ExecuteQuery(ctx, n.Driver,
-		"CREATE (m:Movie {Title: $title, Tagline: $tagline, Year: $year, Budget: $budget, Gross: $gross, Rating: $rating, Duration: $duration}) RETURN m",
+		"MERGE (m:Movie {ID: $id}) SET m += $props RETURN m",
+		map[string]any{
+			"id":    m.ID,
+			"props": movieProps(m),
+		}, neo4j.EagerResultTransformer)
+
+	return err
+}
+
+func (n Neo4jMovieRepo) Update(ctx context.Context, m *domain.MovieBaseInfo) error {
+	_, err := neo4j.ExecuteQuery(ctx, n.Driver,
+		"MATCH (m:Movie {ID: $id}) SET m += $props",
 		map[string]any{
-			"title":    m.Title,
-			"tagline":  m.Tagline,
-			"year":     m.Year,
-			"budget":   m.Budget,
-			"gross":    m.Gross,
-			"duration": m.Duration,
-			"rating":   m.Rating,
+			"id":    m.ID,
+			"props": movieProps(m),
 		}, neo4j.EagerResultTransformer)
 
 	return err
 }
 
+func movieProps(m *domain.MovieBaseInfo) map[string]any {
+	return map[string]any{
+		"ID":                       m.ID,
+		"Title":                    m.Title,
+		"AlternativeName":          m.AlternativeName,
+		"EnName":                   m.EnName,
+		"Type":                     m.Type,
+		"IsSeries":                 m.IsSeries,
+		"Status":                   m.Status,
+		"Year":                     m.Year,
+		"Tagline":                  m.Tagline,
+		"Description":              m.Description,
+		"ShortDescription":         m.ShortDescription,
+		"Duration":                 m.Duration,
+		"AgeRating":                m.AgeRating,
+		"Rating":                   m.Rating,
+		"RatingKp":                 m.Ratings.Kp,
+		"RatingTmdb":               m.Ratings.Tmdb,
+		"RatingFilmCritics":        m.Ratings.FilmCritics,
+		"RatingRussianFilmCritics": m.Ratings.RussianFilmCritics,
+		"RatingAwait":              m.Ratings.Await,
+		"ReviewCount":              m.ReviewInfo.Count,
+		"ReviewPositive":           m.ReviewInfo.Positive,
+		"ReviewPercentage":         m.ReviewInfo.Percentage,
+		"Budget":                   m.Budget,
+		"BudgetCurrency":           m.BudgetCurrency,
+		"Gross":                    m.Gross,
+		"GrossCurrency":            m.GrossCurrency,
+		"GrossUsa":                 m.GrossUsa,
+		"GrossUsaCurrency":         m.GrossUsaCurrency,
+		"GrossRus":                 m.GrossRus,
+		"GrossRusCurrency":         m.GrossRusCurrency,
+		"Genres":                   m.Genres,
+		"Countries":                m.Countries,
+		"FetchedAt":                m.FetchedAt,
+	}
+}
+
+func (n Neo4jMovieRepo) GetStale(ctx context.Context, before time.Time, afterID, limit uint64) ([]uint64, error) {
+	result, err := neo4j.ExecuteQuery(ctx, n.Driver,
+		"MATCH (m:Movie) WHERE m.ID > $afterID AND m.Title IS NOT NULL AND "+
+			"(m.FetchedAt IS NULL OR m.FetchedAt < $before) "+
+			"RETURN m.ID AS id ORDER BY id LIMIT $limit",
+		map[string]any{
+			"before":  before,
+			"afterID": afterID,
+			"limit":   limit,
+		}, neo4j.EagerResultTransformer)
+	if err != nil {
+		logrus.Error(err)
+		return nil, err
+	}
+
+	ids := make([]uint64, 0, len(result.Records))
+	for _, record := range result.Records {
+		id, _, err := neo4j.GetRecordValue[int64](record, "id")
+		if err != nil {
+			return ids, err
+		}
+
+		ids = append(ids, uint64(id))
+	}
+
+	return ids, nil
+}
+
 func (n Neo4jMovieRepo) Delete(ctx context.Context, id uint64) error {
 	_, err := neo4j.ExecuteQuery(ctx, n.Driver,
 		"DELETE (m:Movie {ID: $id})",
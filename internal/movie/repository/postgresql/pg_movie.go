@@ -4,9 +4,17 @@ import (
 	"Kinopoisk-Parser/internal/domain"
 	"context"
 	"database/sql"
+	"github.com/lib/pq"
 	"github.com/sirupsen/logrus"
+	"time"
 )
 
+const movieColumns = `id, title, alternative_name, en_name, movie_type, status, movie_year, tagline, description,
+			 short_description, duration, age_rating, rating, rating_kp, rating_tmdb, rating_film_critics,
+			 rating_russian_film_critics, rating_await, budget, budget_currency, gross, gross_currency, genres,
+			 countries, fetched_at, is_series, gross_usa, gross_usa_currency, gross_rus, gross_rus_currency,
+			 review_count, review_positive, review_percentage`
+
 type pgMovieRepo struct {
 	Conn *sql.DB
 }
@@ -16,9 +24,19 @@ func New(conn *sql.DB) domain.MovieRepository {
 }
 
 func (r pgMovieRepo) GetByID(ctx context.Context, id uint64) (domain.MovieBaseInfo, error) {
-	query := `SELECT id, title, movie_year, tagline, duration, rating, budget, gross FROM movie WHERE id = $1;`
+	query := `SELECT ` + movieColumns + ` FROM movie WHERE id = $1;`
+
+	return r.getOne(ctx, query, id)
+}
 
-	rows, err := r.Conn.QueryContext(ctx, query, id)
+func (r pgMovieRepo) GetByTitle(ctx context.Context, title string) (domain.MovieBaseInfo, error) {
+	query := `SELECT ` + movieColumns + ` FROM movie WHERE title = $1;`
+
+	return r.getOne(ctx, query, title)
+}
+
+func (r pgMovieRepo) getOne(ctx context.Context, query string, arg any) (domain.MovieBaseInfo, error) {
+	rows, err := r.Conn.QueryContext(ctx, query, arg)
 	if err != nil {
 		logrus.Errorf("Repo error: %v", err)
 		return domain.MovieBaseInfo{}, err
@@ -32,17 +50,13 @@ func (r pgMovieRepo) GetByID(ctx context.Context, id uint64) (domain.MovieBaseIn
 	}()
 
 	movie := domain.MovieBaseInfo{}
-	err = rows.Scan(
-		&movie.ID,
-		&movie.Title,
-		&movie.Year,
-		&movie.Tagline,
-		&movie.Duration,
-		&movie.Rating,
-		&movie.Budget,
-		&movie.Gross)
+	if rows.Next() {
+		movie, err = scanMovie(rows)
+	} else {
+		err = domain.MovieNotFound
+	}
 
-	if err != nil {
+	if err != nil && err != domain.MovieNotFound {
 		logrus.Errorf("Repo error: %v", err)
 		return domain.MovieBaseInfo{}, err
 	}
@@ -50,13 +64,14 @@ func (r pgMovieRepo) GetByID(ctx context.Context, id uint64) (domain.MovieBaseIn
 	return movie, err
 }
 
-func (r pgMovieRepo) GetByTitle(ctx context.Context, title string) (domain.MovieBaseInfo, error) {
-	query := `SELECT id, title, movie_year, tagline, duration, rating, budget, gross FROM movie WHERE title = $1;`
+func (r pgMovieRepo) GetMovies(ctx context.Context, limit, offset uint64) ([]domain.MovieBaseInfo, error) {
+	query := `SELECT ` + movieColumns + ` FROM movie LIMIT $1 OFFSET $2;`
+
+	rows, err := r.Conn.QueryContext(ctx, query, limit, offset)
 
-	rows, err := r.Conn.QueryContext(ctx, query, title)
 	if err != nil {
 		logrus.Errorf("Repo error: %v", err)
-		return domain.MovieBaseInfo{}, err
+		return nil, err
 	}
 
 	defer func() {
@@ -66,34 +81,120 @@ func (r pgMovieRepo) GetByTitle(ctx context.Context, title string) (domain.Movie
 		}
 	}()
 
-	movie := domain.MovieBaseInfo{}
-	if rows.Next() {
-		err = rows.Scan(
-			&movie.ID,
-			&movie.Title,
-			&movie.Year,
-			&movie.Tagline,
-			&movie.Duration,
-			&movie.Rating,
-			&movie.Budget,
-			&movie.Gross)
-	} else {
-		err = domain.MovieNotFound
+	result := make([]domain.MovieBaseInfo, 0)
+	for rows.Next() {
+		tmpMovie, err := scanMovie(rows)
+		if err != nil {
+			logrus.Errorf("Repo error: %v", err)
+			return nil, err
+		}
+
+		result = append(result, tmpMovie)
 	}
 
-	if err != nil && err != domain.MovieNotFound {
+	return result, err
+}
+
+func scanMovie(rows *sql.Rows) (domain.MovieBaseInfo, error) {
+	movie := domain.MovieBaseInfo{}
+	err := rows.Scan(
+		&movie.ID,
+		&movie.Title,
+		&movie.AlternativeName,
+		&movie.EnName,
+		&movie.Type,
+		&movie.Status,
+		&movie.Year,
+		&movie.Tagline,
+		&movie.Description,
+		&movie.ShortDescription,
+		&movie.Duration,
+		&movie.AgeRating,
+		&movie.Rating,
+		&movie.Ratings.Kp,
+		&movie.Ratings.Tmdb,
+		&movie.Ratings.FilmCritics,
+		&movie.Ratings.RussianFilmCritics,
+		&movie.Ratings.Await,
+		&movie.Budget,
+		&movie.BudgetCurrency,
+		&movie.Gross,
+		&movie.GrossCurrency,
+		pq.Array(&movie.Genres),
+		pq.Array(&movie.Countries),
+		&movie.FetchedAt,
+		&movie.IsSeries,
+		&movie.GrossUsa,
+		&movie.GrossUsaCurrency,
+		&movie.GrossRus,
+		&movie.GrossRusCurrency,
+		&movie.ReviewInfo.Count,
+		&movie.ReviewInfo.Positive,
+		&movie.ReviewInfo.Percentage)
+
+	// The rating column keeps the IMDb rating.
+	movie.Ratings.Imdb = movie.Rating
+
+	return movie, err
+}
+
+func (r pgMovieRepo) Count(ctx context.Context) (uint64, error) {
+	query := `SELECT count(*) FROM movie;`
+
+	var result uint64
+	err := r.Conn.QueryRowContext(ctx, query).Scan(&result)
+	if err != nil {
 		logrus.Errorf("Repo error: %v", err)
-		return domain.MovieBaseInfo{}, err
+		return 0, err
 	}
 
-	return movie, err
+	return result, nil
 }
 
-func (r pgMovieRepo) GetMovies(ctx context.Context, limit, offset uint64) ([]domain.MovieBaseInfo, error) {
-	query := `SELECT id, title, movie_year, tagline, duration, rating, budget, gross FROM movie LIMIT $1 OFFSET $2;`
+func (r pgMovieRepo) Add(ctx context.Context, m *domain.MovieBaseInfo) error {
+	query := `INSERT into movie(` + movieColumns + `) VALUES 
+			 ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19, $20, $21, $22,
+			 $23, $24, $25, $26, $27, $28, $29, $30, $31, $32, $33);`
+	_, err := r.Conn.ExecContext(ctx, query, movieArgs(m)...)
 
-	rows, err := r.Conn.QueryContext(ctx, query, limit, offset)
+	return err
+}
+
+func (r pgMovieRepo) Update(ctx context.Context, m *domain.MovieBaseInfo) error {
+	query := `UPDATE movie SET title = $2, alternative_name = $3, en_name = $4, movie_type = $5, status = $6,
+			 movie_year = $7, tagline = $8, description = $9, short_description = $10, duration = $11,
+			 age_rating = $12, rating = $13, rating_kp = $14, rating_tmdb = $15, rating_film_critics = $16,
+			 rating_russian_film_critics = $17, rating_await = $18, budget = $19, budget_currency = $20, gross = $21,
+			 gross_currency = $22, genres = $23, countries = $24, fetched_at = $25,
+			 is_series = $26, gross_usa = $27, gross_usa_currency = $28, gross_rus = $29, gross_rus_currency = $30,
+			 review_count = $31, review_positive = $32, review_percentage = $33
+			 WHERE id = $1;`
+	_, err := r.Conn.ExecContext(ctx, query, movieArgs(m)...)
+
+	return err
+}
+
+func movieArgs(m *domain.MovieBaseInfo) []any {
+	return []any{m.ID, m.Title, m.AlternativeName, m.EnName, m.Type, m.Status, m.Year, m.Tagline, m.Description,
+		m.ShortDescription, m.Duration, m.AgeRating, m.Rating, m.Ratings.Kp, m.Ratings.Tmdb, m.Ratings.FilmCritics,
+		m.Ratings.RussianFilmCritics, m.Ratings.Await, m.Budget, m.BudgetCurrency, m.Gross, m.GrossCurrency,
+		pq.Array(nonNil(m.Genres)), pq.Array(nonNil(m.Countries)), m.FetchedAt,
+		m.IsSeries, m.GrossUsa, m.GrossUsaCurrency, m.GrossRus, m.GrossRusCurrency,
+		m.ReviewInfo.Count, m.ReviewInfo.Positive, m.ReviewInfo.Percentage}
+}
+
+func nonNil(values []string) []string {
+	if values == nil {
+		return []string{}
+	}
+
+	return values
+}
+
+func (r pgMovieRepo) GetStale(ctx context.Context, before time.Time, afterID, limit uint64) ([]uint64, error) {
+	query := `SELECT id FROM movie WHERE fetched_at < $1 AND id > $2 ORDER BY id LIMIT $3;`
 
+	rows, err := r.Conn.QueryContext(ctx, query, before, afterID, limit)
 	if err != nil {
 		logrus.Errorf("Repo error: %v", err)
 		return nil, err
@@ -106,39 +207,21 @@ func (r pgMovieRepo) GetMovies(ctx context.Context, limit, offset uint64) ([]dom
 		}
 	}()
 
-	result := make([]domain.MovieBaseInfo, 0)
+	result := make([]uint64, 0)
 	for rows.Next() {
-		tmpMovie := domain.MovieBaseInfo{}
-		err = rows.Scan(
-			&tmpMovie.ID,
-			&tmpMovie.Title,
-			&tmpMovie.Year,
-			&tmpMovie.Tagline,
-			&tmpMovie.Duration,
-			&tmpMovie.Rating,
-			&tmpMovie.Budget,
-			&tmpMovie.Gross)
-
+		var id uint64
+		err = rows.Scan(&id)
 		if err != nil {
 			logrus.Errorf("Repo error: %v", err)
 			return nil, err
 		}
 
-		result = append(result, tmpMovie)
+		result = append(result, id)
 	}
 
 	return result, err
 }
 
-func (r pgMovieRepo) Add(ctx context.Context, m *domain.MovieBaseInfo) error {
-	query := `INSERT into movie(id, title, movie_year, tagline, duration, rating, budget, gross) VALUES 
-			 ($1, $2, $3, $4, $5, $6, $7, $8);`
-	_, err := r.Conn.ExecContext(ctx, query, m.ID, m.Title, m.Year, m.Tagline, m.Duration,
-		m.Rating, m.Budget, m.Gross)
-
-	return err
-}
-
 func (r pgMovieRepo) Delete(ctx context.Context, id uint64) error {
 	query := `DELETE FROM movie WHERE id = $1;`
 
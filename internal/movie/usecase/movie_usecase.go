@@ -50,98 +50,27 @@ func (u *movieUsecase) getAdditionalInfo(ctx context.Context, movie *domain.Movi
 }
 
 func (u *movieUsecase) getAllProfessionals(movie *domain.Movie) error {
-	err := u.getProducers(movie)
+	credits, err := u.personRepo.GetByMovie(context.Background(), movie.BaseInfo.ID)
 	if err != nil {
+		logrus.Errorf("Usecase(getProfessionals) err: %v", err)
 		return err
 	}
 
-	err = u.getDirectors(movie)
-	if err != nil {
-		return err
+	byRole := make(map[uint64][]domain.Credit)
+	for _, credit := range credits {
+		byRole[credit.Role] = append(byRole[credit.Role], credit.Credit)
 	}
 
-	err = u.getWriters(movie)
-	if err != nil {
-		return err
-	}
-
-	err = u.getActors(movie)
-	return err
-}
-
-func (u *movieUsecase) getProducers(movie *domain.Movie) error {
-	producers, err := u.professionalRepo.GetProducersByMovie(context.Background(), movie.BaseInfo.ID)
-	if err != nil {
-		logrus.Errorf("Usecase(getProducers) err: %v", err)
-		return err
-	}
-
-	producersInfo, err := u.addPersons(producers)
-	if err == nil {
-		movie.Producers = producersInfo
-	}
-
-	return err
-}
-
-func (u *movieUsecase) getDirectors(movie *domain.Movie) error {
-	directors, err := u.professionalRepo.GetDirectorsByMovie(context.Background(), movie.BaseInfo.ID)
-	if err != nil {
-		logrus.Errorf("Usecase(getDirectors) err: %v", err)
-		return err
-	}
-
-	directorsInfo, err := u.addPersons(directors)
-	if err == nil {
-		movie.Directors = directorsInfo
-	}
-
-	return err
-}
-
-func (u *movieUsecase) getWriters(movie *domain.Movie) error {
-	writers, err := u.professionalRepo.GetWritersByMovie(context.Background(), movie.BaseInfo.ID)
-	if err != nil {
-		logrus.Errorf("Usecase(getWriters) err: %v", err)
-		return err
-	}
-
-	writersInfo, err := u.addPersons(writers)
-	if err == nil {
-		movie.Writers = writersInfo
-	}
-
-	return err
-}
-
-func (u *movieUsecase) getActors(movie *domain.Movie) error {
-	actors, err := u.professionalRepo.GetActorsByMovie(context.Background(), movie.BaseInfo.ID)
-	if err != nil {
-		logrus.Errorf("Usecase(getActors) err: %v", err)
-		return err
-	}
-
-	actorsInfo, err := u.addPersons(actors)
-	if err == nil {
-		movie.Actors = actorsInfo
-	}
-
-	return err
-}
-
-func (u *movieUsecase) addPersons(professions []domain.Profession) ([]domain.Person, error) {
-	result := make([]domain.Person, 0)
-	for _, person := range professions {
-		personInfo, err := u.personRepo.GetByID(context.Background(), person.PersonID)
-		if err != nil {
-			logrus.Errorf("Usecase(getActors) err: %v", err)
-			return nil, err
+	for _, role := range domain.Roles {
+		roleCredits := byRole[role.ID]
+		if roleCredits == nil {
+			roleCredits = make([]domain.Credit, 0)
 		}
 
-		result = append(result, personInfo)
+		movie.SetCredits(role, roleCredits)
 	}
 
-	return result, nil
+	return nil
 }
 
 func (u *movieUsecase) GetByTitle(ctx context.Context, title string) (result domain.Movie, err error) {
@@ -188,6 +117,19 @@ func (u *movieUsecase) GetMovies(ctx context.Context, limit, offset uint64) ([]d
 	return result, nil
 }
 
+func (u *movieUsecase) Count(ctx context.Context) (uint64, error) {
+	ctx, cancel := context.WithTimeout(ctx, u.contextTimeout)
+	defer cancel()
+
+	count, err := u.movieRepo.Count(ctx)
+	if err != nil {
+		logrus.Errorf("Usecase: %v", err)
+		return 0, err
+	}
+
+	return count, nil
+}
+
 func (u *movieUsecase) Add(ctx context.Context, m *domain.Movie) error {
 	ctx, cancel := context.WithTimeout(ctx, u.contextTimeout)
 	defer cancel()
@@ -214,39 +156,23 @@ func (u *movieUsecase) Add(ctx context.Context, m *domain.Movie) error {
 }
 
 func (u *movieUsecase) addAllPersonsToDB(m *domain.Movie) error {
-	err := u.addPersonsToDB(m.Directors)
-	if err != nil {
-		logrus.Errorf("Usecase: %v", err)
-		return fmt.Errorf("usecase: %v", err)
-	}
-
-	err = u.addPersonsToDB(m.Producers)
-	if err != nil {
-		logrus.Errorf("Usecase: %v", err)
-		return fmt.Errorf("usecase: %v", err)
-	}
-
-	err = u.addPersonsToDB(m.Writers)
-	if err != nil {
-		logrus.Errorf("Usecase: %v", err)
-		return fmt.Errorf("usecase: %v", err)
-	}
-
-	err = u.addPersonsToDB(m.Actors)
-	if err != nil {
-		logrus.Errorf("Usecase: %v", err)
-		return fmt.Errorf("usecase: %v", err)
+	for _, role := range domain.Roles {
+		err := u.addPersonsToDB(m.Credits(role))
+		if err != nil {
+			logrus.Errorf("Usecase: %v", err)
+			return fmt.Errorf("usecase: %v", err)
+		}
 	}
 
 	return nil
 }
 
-func (u *movieUsecase) addPersonsToDB(persons []domain.Person) error {
+func (u *movieUsecase) addPersonsToDB(persons []domain.Credit) error {
 	for _, el := range persons {
 		_, err := u.personRepo.GetByFullName(context.Background(), el.FullName)
 		switch err {
 		case domain.PersonNotFound:
-			err = u.personRepo.Add(context.Background(), &el)
+			err = u.personRepo.Add(context.Background(), &el.Person)
 			if err != nil {
 				logrus.Errorf("Usecase: %v", err)
 				return fmt.Errorf("usecase: %v", err)
@@ -263,57 +189,296 @@ func (u *movieUsecase) addPersonsToDB(persons []domain.Person) error {
 }
 
 func (u *movieUsecase) addAllProfessionsToDB(m *domain.Movie) error {
-	err := u.addProfessionalToDB(m.Directors, m.BaseInfo.ID, domain.DirectorRole)
+	for _, role := range domain.Roles {
+		err := u.addProfessionalToDB(m.Credits(role), m.BaseInfo.ID, role.ID)
+		if err != nil {
+			logrus.Errorf("Usecase: %v", err)
+			return fmt.Errorf("usecase: %v", err)
+		}
+	}
+
+	return nil
+}
+
+func (u *movieUsecase) addProfessionalToDB(persons []domain.Credit, movieID, role uint64) error {
+	for _, person := range persons {
+		err := u.professionalRepo.Add(context.Background(), newProfession(movieID, role, person))
+		if err != nil {
+			logrus.Errorf("Usecase: %v", err)
+			return fmt.Errorf("usecase: %v", err)
+		}
+	}
+
+	return nil
+}
+
+func (u *movieUsecase) Delete(ctx context.Context, id uint64) error {
+	err := u.movieRepo.Delete(ctx, id)
 	if err != nil {
 		logrus.Errorf("Usecase: %v", err)
 		return fmt.Errorf("usecase: %v", err)
 	}
 
-	err = u.addProfessionalToDB(m.Producers, m.BaseInfo.ID, domain.ProducerRole)
+	err = u.professionalRepo.Delete(ctx, id)
 	if err != nil {
 		logrus.Errorf("Usecase: %v", err)
 		return fmt.Errorf("usecase: %v", err)
 	}
 
-	err = u.addProfessionalToDB(m.Writers, m.BaseInfo.ID, domain.WriterRole)
+	return nil
+}
+
+func (u *movieUsecase) GetStale(ctx context.Context, kind uint64, before time.Time, afterID, limit uint64) ([]uint64, error) {
+	ctx, cancel := context.WithTimeout(ctx, u.contextTimeout)
+	defer cancel()
+
+	var (
+		ids []uint64
+		err error
+	)
+
+	if kind == domain.PersonItem {
+		ids, err = u.personRepo.GetStale(ctx, before, afterID, limit)
+	} else {
+		ids, err = u.movieRepo.GetStale(ctx, before, afterID, limit)
+	}
+
 	if err != nil {
 		logrus.Errorf("Usecase: %v", err)
-		return fmt.Errorf("usecase: %v", err)
+		return nil, fmt.Errorf("usecase: %v", err)
 	}
 
-	err = u.addProfessionalToDB(m.Actors, m.BaseInfo.ID, domain.ActorsRole)
+	return ids, nil
+}
+
+// Update rewrites a stored movie in place and returns the number of fields
+// that differ from the stored version. A changed cast counts as one field.
+func (u *movieUsecase) Update(ctx context.Context, m *domain.Movie) (uint64, error) {
+	ctx, cancel := context.WithTimeout(ctx, u.contextTimeout)
+	defer cancel()
+
+	stored, err := u.movieRepo.GetByID(ctx, m.BaseInfo.ID)
+	if err != nil {
+		return 0, err
+	}
+
+	changed := changedMovieFields(stored, m.BaseInfo)
+
+	err = u.movieRepo.Update(ctx, &m.BaseInfo)
 	if err != nil {
 		logrus.Errorf("Usecase: %v", err)
-		return fmt.Errorf("usecase: %v", err)
+		return 0, fmt.Errorf("usecase: %v", err)
 	}
 
-	return nil
+	err = u.addAllPersonsToDB(m)
+	if err != nil {
+		logrus.Errorf("Usecase: %v", err)
+		return 0, fmt.Errorf("usecase: %v", err)
+	}
+
+	castChanged, err := u.updateAllProfessions(ctx, m)
+	if err != nil {
+		logrus.Errorf("Usecase: %v", err)
+		return 0, fmt.Errorf("usecase: %v", err)
+	}
+
+	if castChanged {
+		changed += 1
+	}
+
+	return changed, nil
+}
+
+func (u *movieUsecase) updateAllProfessions(ctx context.Context, m *domain.Movie) (bool, error) {
+	changed := false
+	for _, role := range domain.Roles {
+		stored, err := u.professionalRepo.GetByMovie(ctx, m.BaseInfo.ID, role.ID)
+		if err != nil {
+			return false, err
+		}
+
+		roleChanged, err := u.updateProfessions(ctx, m.BaseInfo.ID, role.ID, stored, m.Credits(role))
+		if err != nil {
+			return false, err
+		}
+
+		changed = changed || roleChanged
+	}
+
+	return changed, nil
 }
 
-func (u *movieUsecase) addProfessionalToDB(persons []domain.Person, movieID, role uint64) error {
+func (u *movieUsecase) updateProfessions(ctx context.Context, movieID, role uint64, stored []domain.Profession,
+	persons []domain.Credit) (bool, error) {
+	storedByPerson := make(map[uint64]domain.Profession, len(stored))
+	for _, profession := range stored {
+		storedByPerson[profession.PersonID] = profession
+	}
+
+	changed := false
+	fetchedIDs := make(map[uint64]bool, len(persons))
 	for _, person := range persons {
-		err := u.professionalRepo.Add(context.Background(), movieID, person.ID, role)
+		if fetchedIDs[person.ID] {
+			continue
+		}
+		fetchedIDs[person.ID] = true
+
+		profession, ok := storedByPerson[person.ID]
+		delete(storedByPerson, person.ID)
+
+		if ok && profession.Character == person.Character && profession.Position == person.Position {
+			continue
+		}
+
+		if ok {
+			err := u.professionalRepo.Remove(ctx, movieID, person.ID, role)
+			if err != nil {
+				return false, err
+			}
+		}
+
+		err := u.professionalRepo.Add(ctx, newProfession(movieID, role, person))
 		if err != nil {
-			logrus.Errorf("Usecase: %v", err)
-			return fmt.Errorf("usecase: %v", err)
+			return false, err
 		}
+		changed = true
 	}
 
-	return nil
+	for personID := range storedByPerson {
+		err := u.professionalRepo.Remove(ctx, movieID, personID, role)
+		if err != nil {
+			return false, err
+		}
+		changed = true
+	}
+
+	return changed, nil
 }
 
-func (u *movieUsecase) Delete(ctx context.Context, id uint64) error {
-	err := u.movieRepo.Delete(ctx, id)
-	if err != nil {
+func newProfession(movieID, role uint64, credit domain.Credit) *domain.Profession {
+	return &domain.Profession{
+		MovieID:   movieID,
+		PersonID:  credit.ID,
+		Role:      role,
+		Character: credit.Character,
+		Position:  credit.Position,
+	}
+}
+
+func changedMovieFields(stored, fetched domain.MovieBaseInfo) uint64 {
+	var changed uint64
+
+	for _, differs := range []bool{
+		stored.Title != fetched.Title,
+		stored.AlternativeName != fetched.AlternativeName,
+		stored.EnName != fetched.EnName,
+		stored.Type != fetched.Type,
+		stored.IsSeries != fetched.IsSeries,
+		stored.Status != fetched.Status,
+		stored.Year != fetched.Year,
+		stored.Tagline != fetched.Tagline,
+		stored.Description != fetched.Description,
+		stored.ShortDescription != fetched.ShortDescription,
+		stored.Duration != fetched.Duration,
+		stored.AgeRating != fetched.AgeRating,
+		stored.Rating != fetched.Rating,
+		stored.Ratings.Kp != fetched.Ratings.Kp,
+		stored.Ratings.Tmdb != fetched.Ratings.Tmdb,
+		stored.Ratings.FilmCritics != fetched.Ratings.FilmCritics,
+		stored.Ratings.RussianFilmCritics != fetched.Ratings.RussianFilmCritics,
+		stored.Ratings.Await != fetched.Ratings.Await,
+		stored.ReviewInfo != fetched.ReviewInfo,
+		stored.Budget != fetched.Budget,
+		stored.BudgetCurrency != fetched.BudgetCurrency,
+		stored.Gross != fetched.Gross,
+		stored.GrossCurrency != fetched.GrossCurrency,
+		stored.GrossUsa != fetched.GrossUsa,
+		stored.GrossUsaCurrency != fetched.GrossUsaCurrency,
+		stored.GrossRus != fetched.GrossRus,
+		stored.GrossRusCurrency != fetched.GrossRusCurrency,
+		!equalStrings(stored.Genres, fetched.Genres),
+		!equalStrings(stored.Countries, fetched.Countries),
+	} {
+		if differs {
+			changed += 1
+		}
+	}
+
+	return changed
+}
+
+func equalStrings(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+
+	return true
+}
+
+// SavePerson stores a person fetched from the person endpoint, adding it when
+// it is not known yet, and returns the number of changed fields.
+func (u *movieUsecase) SavePerson(ctx context.Context, p *domain.Person) (uint64, error) {
+	ctx, cancel := context.WithTimeout(ctx, u.contextTimeout)
+	defer cancel()
+
+	stored, err := u.personRepo.GetByID(ctx, p.ID)
+	switch err {
+	case nil:
+	case domain.PersonNotFound:
+		err = u.personRepo.Add(ctx, p)
+		if err != nil {
+			logrus.Errorf("Usecase: %v", err)
+			return 0, fmt.Errorf("usecase: %v", err)
+		}
+		return 0, nil
+	default:
 		logrus.Errorf("Usecase: %v", err)
-		return fmt.Errorf("usecase: %v", err)
+		return 0, fmt.Errorf("usecase: %v", err)
 	}
 
-	err = u.professionalRepo.Delete(ctx, id)
+	changed := changedPersonFields(stored, *p)
+
+	err = u.personRepo.Update(ctx, p)
 	if err != nil {
 		logrus.Errorf("Usecase: %v", err)
-		return fmt.Errorf("usecase: %v", err)
+		return 0, fmt.Errorf("usecase: %v", err)
 	}
 
-	return nil
+	return changed, nil
+}
+
+func changedPersonFields(stored, fetched domain.Person) uint64 {
+	var changed uint64
+
+	for _, differs := range []bool{
+		stored.FullName != fetched.FullName,
+		stored.EnName != fetched.EnName,
+		stored.Photo != fetched.Photo,
+		stored.Sex != fetched.Sex,
+		stored.Age != fetched.Age,
+		stored.Height != fetched.Height,
+		!equalDates(stored.Birthday, fetched.Birthday),
+		!equalDates(stored.Death, fetched.Death),
+		stored.Birthplace != fetched.Birthplace,
+	} {
+		if differs {
+			changed += 1
+		}
+	}
+
+	return changed
+}
+
+func equalDates(a, b *time.Time) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+
+	return a.Equal(*b)
 }
@@ -0,0 +1,71 @@
+package parser
+
+import (
+	"Kinopoisk-Parser/internal/domain"
+	"context"
+	"github.com/sirupsen/logrus"
+)
+
+func (p *Parser) parseMovieAwards(ctx context.Context, item domain.FrontierItem) error {
+	logrus.Infof("Parse awards of movie with index = %d", item.ID)
+
+	awards, err := p.fetchAwards(ctx, item.ID, p.Source.GetMovieAwards)
+	if err != nil {
+		return err
+	}
+
+	for i := range awards {
+		awards[i].MovieID = item.ID
+		awards[i].PersonID = 0
+	}
+
+	return p.Awards.SaveMovieAwards(context.Background(), item.ID, awards)
+}
+
+func (p *Parser) parsePersonAwards(ctx context.Context, item domain.FrontierItem) error {
+	logrus.Infof("Parse awards of person with index = %d", item.ID)
+
+	awards, err := p.fetchAwards(ctx, item.ID, p.Source.GetPersonAwards)
+	if err != nil {
+		return err
+	}
+
+	for i := range awards {
+		awards[i].PersonID = item.ID
+	}
+
+	return p.Awards.SavePersonAwards(context.Background(), item.ID, awards)
+}
+
+func (p *Parser) fetchAwards(ctx context.Context, id uint64,
+	fetch func(ctx context.Context, id, page, limit uint64) (domain.AwardPageDTO, error)) ([]domain.Award, error) {
+	docs, err := fetchPages(ctx, id, p.PageLimit, fetch)
+	if err != nil {
+		logrus.Errorf("Parser error awards fetch: %v", err)
+		return nil, err
+	}
+
+	awards := make([]domain.Award, 0, len(docs))
+	for _, award := range docs {
+		awards = append(awards, newAward(award))
+	}
+
+	return awards, nil
+}
+
+func newAward(award domain.AwardDTO) domain.Award {
+	result := domain.Award{
+		MovieID:    uint64(award.MovieId),
+		PersonID:   uint64(award.PersonId),
+		Award:      award.Nomination.Award.Title,
+		Nomination: award.Nomination.Title,
+		Year:       uint64(award.Nomination.Award.Year),
+		Winner:     award.Winning,
+	}
+
+	if award.Movie != nil {
+		result.MovieID = uint64(award.Movie.Id)
+	}
+
+	return result
+}
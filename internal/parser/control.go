@@ -0,0 +1,141 @@
+package parser
+
+import (
+	"Kinopoisk-Parser/internal/domain"
+	"context"
+	"sort"
+	"sync/atomic"
+)
+
+func (p *Parser) Pause() {
+	p.pauseMutex.Lock()
+	defer p.pauseMutex.Unlock()
+
+	if p.resumed == nil {
+		p.resumed = make(chan struct{})
+	}
+}
+
+func (p *Parser) Resume() {
+	p.pauseMutex.Lock()
+	defer p.pauseMutex.Unlock()
+
+	if p.resumed != nil {
+		close(p.resumed)
+		p.resumed = nil
+	}
+}
+
+func (p *Parser) Paused() bool {
+	p.pauseMutex.Lock()
+	defer p.pauseMutex.Unlock()
+
+	return p.resumed != nil
+}
+
+func (p *Parser) waitIfPaused(ctx context.Context) {
+	p.pauseMutex.Lock()
+	resumed := p.resumed
+	p.pauseMutex.Unlock()
+
+	if resumed == nil {
+		return
+	}
+
+	select {
+	case <-ctx.Done():
+	case <-resumed:
+	}
+}
+
+// Stats returns the counters of the current run. Queue length, state and rate
+// are filled in by the caller.
+func (p *Parser) Stats() domain.CrawlStatus {
+	p.statsMutex.Lock()
+	defer p.statsMutex.Unlock()
+
+	workers := make([]int, 0, len(p.current))
+	for worker := range p.current {
+		workers = append(workers, worker)
+	}
+	sort.Ints(workers)
+
+	current := make([]domain.FrontierItem, 0, len(workers))
+	for _, worker := range workers {
+		current = append(current, p.current[worker])
+	}
+
+	return domain.CrawlStatus{
+		ProcessedMovies:  atomic.LoadUint64(&p.processedMovies),
+		ProcessedPersons: atomic.LoadUint64(&p.processedPersons),
+		ProcessedPages:   atomic.LoadUint64(&p.processedPages),
+		ProcessedSeasons: atomic.LoadUint64(&p.processedSeasons),
+		ProcessedAwards:  atomic.LoadUint64(&p.processedAwards),
+		ProcessedReviews: atomic.LoadUint64(&p.processedReviews),
+		ProcessedStudios: atomic.LoadUint64(&p.processedStudios),
+		StoredMovies:     atomic.LoadUint64(&p.storedMovies),
+		Errors:           atomic.LoadUint64(&p.errorCount),
+		ChangedFields:    atomic.LoadUint64(&p.changedFields),
+		LastError:        p.lastError,
+		Current:          current,
+	}
+}
+
+func (p *Parser) resetStats() {
+	p.statsMutex.Lock()
+	defer p.statsMutex.Unlock()
+
+	atomic.StoreUint64(&p.processedMovies, 0)
+	atomic.StoreUint64(&p.processedPersons, 0)
+	atomic.StoreUint64(&p.processedPages, 0)
+	atomic.StoreUint64(&p.processedSeasons, 0)
+	atomic.StoreUint64(&p.processedAwards, 0)
+	atomic.StoreUint64(&p.processedReviews, 0)
+	atomic.StoreUint64(&p.processedStudios, 0)
+	atomic.StoreUint64(&p.errorCount, 0)
+	atomic.StoreUint64(&p.changedFields, 0)
+	p.lastError = ""
+	p.current = map[int]domain.FrontierItem{}
+}
+
+func (p *Parser) setCurrent(worker int, item domain.FrontierItem) {
+	p.statsMutex.Lock()
+	defer p.statsMutex.Unlock()
+
+	p.current[worker] = item
+}
+
+func (p *Parser) clearCurrent(worker int) {
+	p.statsMutex.Lock()
+	defer p.statsMutex.Unlock()
+
+	delete(p.current, worker)
+}
+
+func (p *Parser) countProcessed(item domain.FrontierItem) {
+	switch item.Kind {
+	case domain.PersonItem:
+		atomic.AddUint64(&p.processedPersons, 1)
+	case domain.PageItem:
+		atomic.AddUint64(&p.processedPages, 1)
+	case domain.SeasonItem:
+		atomic.AddUint64(&p.processedSeasons, 1)
+	case domain.MovieAwardsItem, domain.PersonAwardsItem:
+		atomic.AddUint64(&p.processedAwards, 1)
+	case domain.ReviewItem:
+		atomic.AddUint64(&p.processedReviews, 1)
+	case domain.StudioItem:
+		atomic.AddUint64(&p.processedStudios, 1)
+	default:
+		atomic.AddUint64(&p.processedMovies, 1)
+	}
+}
+
+func (p *Parser) countError(err error) {
+	atomic.AddUint64(&p.errorCount, 1)
+
+	p.statsMutex.Lock()
+	defer p.statsMutex.Unlock()
+
+	p.lastError = err.Error()
+}
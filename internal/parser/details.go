@@ -0,0 +1,44 @@
+package parser
+
+import (
+	"Kinopoisk-Parser/internal/domain"
+	"context"
+	"github.com/sirupsen/logrus"
+)
+
+// enqueueDetail schedules a detail of a stored movie or person, like its
+// seasons or awards. Details are fetched as separate items, so a failure is
+// retried without fetching the movie or person again.
+func (p *Parser) enqueueDetail(kind uint64, id int, priority float64, parent domain.FrontierItem) {
+	item := domain.FrontierItem{
+		ID:       uint64(id),
+		Kind:     kind,
+		Priority: priority,
+		Depth:    parent.Depth,
+		MaxDepth: parent.MaxDepth,
+	}
+
+	err := p.Frontier.Push(context.Background(), item)
+	if err != nil {
+		logrus.Errorf("Parser error frontier push: %v", err)
+	}
+}
+
+// fetchPages collects the documents of every page of a paged endpoint for the
+// movie or person with the given id.
+func fetchPages[T any](ctx context.Context, id, limit uint64,
+	fetch func(ctx context.Context, id, page, limit uint64) (domain.PageDTO[T], error)) ([]T, error) {
+	docs := make([]T, 0)
+	for page := startPage; ; page++ {
+		result, err := fetch(ctx, id, page, limit)
+		if err != nil {
+			return nil, err
+		}
+
+		docs = append(docs, result.Docs...)
+
+		if result.Last(page) {
+			return docs, nil
+		}
+	}
+}
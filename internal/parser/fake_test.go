@@ -0,0 +1,407 @@
+package parser
+
+import (
+	"Kinopoisk-Parser/config"
+	"Kinopoisk-Parser/internal/domain"
+	"Kinopoisk-Parser/internal/source/fixture"
+	"context"
+	"sync"
+	"time"
+)
+
+const fixtureDir = "../../fixtures"
+
+// The fakes embed the interface they stand for, so a call the parser is not
+// expected to make panics instead of passing silently.
+
+// fakeSource counts the movie fetches of the fixture source and fails them
+// with err when it is set.
+type fakeSource struct {
+	domain.MovieSource
+	mutex        sync.Mutex
+	movieFetches map[uint64]int
+	err          error
+}
+
+func (f *fakeSource) GetMovie(ctx context.Context, id uint64) (domain.MovieDTO, error) {
+	f.mutex.Lock()
+	f.movieFetches[id]++
+	err := f.err
+	f.mutex.Unlock()
+
+	if err != nil {
+		return domain.MovieDTO{}, err
+	}
+
+	return f.MovieSource.GetMovie(ctx, id)
+}
+
+type fakeMovies struct {
+	domain.MovieUsecase
+	mutex   sync.Mutex
+	movies  map[uint64]domain.Movie
+	persons map[uint64]domain.Person
+}
+
+func (f *fakeMovies) Add(ctx context.Context, m *domain.Movie) error {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	f.movies[m.BaseInfo.ID] = *m
+
+	return nil
+}
+
+func (f *fakeMovies) Update(ctx context.Context, m *domain.Movie) (uint64, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if _, ok := f.movies[m.BaseInfo.ID]; !ok {
+		return 0, domain.MovieNotFound
+	}
+	f.movies[m.BaseInfo.ID] = *m
+
+	return 0, nil
+}
+
+func (f *fakeMovies) SavePerson(ctx context.Context, p *domain.Person) (uint64, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	f.persons[p.ID] = *p
+
+	return 0, nil
+}
+
+type fakeSeasons struct {
+	domain.SeasonUsecase
+	seasons []domain.Season
+}
+
+func (f *fakeSeasons) Save(ctx context.Context, seasons []domain.Season) error {
+	f.seasons = append(f.seasons, seasons...)
+
+	return nil
+}
+
+type fakeRelations struct {
+	domain.RelationUsecase
+	relations map[uint64][]domain.MovieRelation
+}
+
+func (f *fakeRelations) Save(ctx context.Context, movieID uint64, relations []domain.MovieRelation) error {
+	f.relations[movieID] = relations
+
+	return nil
+}
+
+type fakeAwards struct {
+	domain.AwardUsecase
+	movieAwards  map[uint64][]domain.Award
+	personAwards map[uint64][]domain.Award
+}
+
+func (f *fakeAwards) SaveMovieAwards(ctx context.Context, movieID uint64, awards []domain.Award) error {
+	f.movieAwards[movieID] = awards
+
+	return nil
+}
+
+func (f *fakeAwards) SavePersonAwards(ctx context.Context, personID uint64, awards []domain.Award) error {
+	f.personAwards[personID] = awards
+
+	return nil
+}
+
+type fakeReviews struct {
+	domain.ReviewUsecase
+	reviews map[uint64][]domain.Review
+}
+
+func (f *fakeReviews) Save(ctx context.Context, movieID uint64, reviews []domain.Review) error {
+	f.reviews[movieID] = reviews
+
+	return nil
+}
+
+type fakeStudios struct {
+	domain.StudioUsecase
+	studios map[uint64][]domain.Studio
+}
+
+func (f *fakeStudios) Save(ctx context.Context, movieID uint64, studios []domain.Studio) error {
+	f.studios[movieID] = studios
+
+	return nil
+}
+
+type fakePremieres struct {
+	domain.PremiereUsecase
+	premieres map[uint64][]domain.Premiere
+}
+
+func (f *fakePremieres) Save(ctx context.Context, movieID uint64, premieres []domain.Premiere) error {
+	f.premieres[movieID] = premieres
+
+	return nil
+}
+
+type fakeFrontier struct {
+	mutex   sync.Mutex
+	items   []domain.FrontierItem
+	claimed map[visitedKey]bool
+	state   map[string]uint64
+}
+
+func (f *fakeFrontier) Push(ctx context.Context, item domain.FrontierItem) error {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	f.items = append(f.items, item)
+
+	return nil
+}
+
+func (f *fakeFrontier) Pop(ctx context.Context, strategy string) (domain.FrontierItem, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	for _, item := range f.items {
+		key := visitedKey{item.Kind, item.ID}
+		if !f.claimed[key] {
+			f.claimed[key] = true
+			return item, nil
+		}
+	}
+
+	return domain.FrontierItem{}, domain.FrontierEmpty
+}
+
+func (f *fakeFrontier) Done(ctx context.Context, kind, id uint64) error {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	items := f.items[:0]
+	for _, item := range f.items {
+		if item.Kind != kind || item.ID != id {
+			items = append(items, item)
+		}
+	}
+	f.items = items
+	delete(f.claimed, visitedKey{kind, id})
+
+	return nil
+}
+
+func (f *fakeFrontier) Release(ctx context.Context, item domain.FrontierItem) error {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	key := visitedKey{item.Kind, item.ID}
+	if !f.claimed[key] {
+		f.items = append(f.items, item)
+	}
+	delete(f.claimed, key)
+
+	return nil
+}
+
+func (f *fakeFrontier) ReleaseClaimed(ctx context.Context, before time.Time) (uint64, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	released := uint64(len(f.claimed))
+	f.claimed = map[visitedKey]bool{}
+
+	return released, nil
+}
+
+func (f *fakeFrontier) Len(ctx context.Context) (uint64, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	return uint64(len(f.items) - len(f.claimed)), nil
+}
+
+func (f *fakeFrontier) GetState(ctx context.Context, key string) (uint64, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	value, ok := f.state[key]
+	if !ok {
+		return 0, domain.StateNotFound
+	}
+
+	return value, nil
+}
+
+func (f *fakeFrontier) SetState(ctx context.Context, key string, value uint64) error {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	f.state[key] = value
+
+	return nil
+}
+
+func (f *fakeFrontier) IncState(ctx context.Context, key string, delta uint64) (uint64, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	f.state[key] += delta
+
+	return f.state[key], nil
+}
+
+// has reports whether an item of the kind and id is in the frontier.
+func (f *fakeFrontier) has(kind, id uint64) bool {
+	_, ok := f.find(kind, id)
+
+	return ok
+}
+
+func (f *fakeFrontier) isClaimed(kind, id uint64) bool {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	return f.claimed[visitedKey{kind, id}]
+}
+
+func (f *fakeFrontier) find(kind, id uint64) (domain.FrontierItem, bool) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	for _, item := range f.items {
+		if item.Kind == kind && item.ID == id {
+			return item, true
+		}
+	}
+
+	return domain.FrontierItem{}, false
+}
+
+type visitedKey struct {
+	kind, id uint64
+}
+
+type fakeVisited struct {
+	mutex    sync.Mutex
+	visited  map[visitedKey]bool
+	rejected map[visitedKey]bool
+}
+
+func (f *fakeVisited) IsVisited(ctx context.Context, kind, id uint64) (bool, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	return f.visited[visitedKey{kind, id}], nil
+}
+
+func (f *fakeVisited) Visit(ctx context.Context, kind, id uint64) (bool, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	key := visitedKey{kind, id}
+	if f.visited[key] {
+		return false, nil
+	}
+	f.visited[key] = true
+
+	return true, nil
+}
+
+func (f *fakeVisited) Forget(ctx context.Context, kind, id uint64) error {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	delete(f.visited, visitedKey{kind, id})
+	delete(f.rejected, visitedKey{kind, id})
+
+	return nil
+}
+
+func (f *fakeVisited) Reject(ctx context.Context, kind, id uint64) error {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	f.rejected[visitedKey{kind, id}] = true
+
+	return nil
+}
+
+func (f *fakeVisited) ForgetRejected(ctx context.Context) (uint64, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	for key := range f.rejected {
+		delete(f.visited, key)
+	}
+	forgotten := uint64(len(f.rejected))
+	f.rejected = map[visitedKey]bool{}
+
+	return forgotten, nil
+}
+
+type fakeFailed struct {
+	mutex  sync.Mutex
+	failed map[visitedKey]string
+}
+
+func (f *fakeFailed) GetFailed(ctx context.Context, limit, offset uint64) ([]domain.FailedItem, error) {
+	return nil, nil
+}
+
+func (f *fakeFailed) Add(ctx context.Context, kind, id uint64, reason string) error {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	f.failed[visitedKey{kind, id}] = reason
+
+	return nil
+}
+
+func (f *fakeFailed) Delete(ctx context.Context, kind, id uint64) error {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	delete(f.failed, visitedKey{kind, id})
+
+	return nil
+}
+
+// testParser bundles a parser reading the fixtures with the fakes it stores to.
+type testParser struct {
+	*Parser
+	source    *fakeSource
+	movies    *fakeMovies
+	seasons   *fakeSeasons
+	relations *fakeRelations
+	awards    *fakeAwards
+	reviews   *fakeReviews
+	studios   *fakeStudios
+	premieres *fakePremieres
+	frontier  *fakeFrontier
+	visited   *fakeVisited
+	failed    *fakeFailed
+}
+
+func newTestParser(params config.CrawlerParams) *testParser {
+	t := &testParser{
+		source:    &fakeSource{MovieSource: fixture.New(fixtureDir), movieFetches: map[uint64]int{}},
+		movies:    &fakeMovies{movies: map[uint64]domain.Movie{}, persons: map[uint64]domain.Person{}},
+		seasons:   &fakeSeasons{},
+		relations: &fakeRelations{relations: map[uint64][]domain.MovieRelation{}},
+		awards:    &fakeAwards{movieAwards: map[uint64][]domain.Award{}, personAwards: map[uint64][]domain.Award{}},
+		reviews:   &fakeReviews{reviews: map[uint64][]domain.Review{}},
+		studios:   &fakeStudios{studios: map[uint64][]domain.Studio{}},
+		premieres: &fakePremieres{premieres: map[uint64][]domain.Premiere{}},
+		frontier:  &fakeFrontier{claimed: map[visitedKey]bool{}, state: map[string]uint64{}},
+		visited:   &fakeVisited{visited: map[visitedKey]bool{}, rejected: map[visitedKey]bool{}},
+		failed:    &fakeFailed{failed: map[visitedKey]string{}},
+	}
+
+	t.Parser = NewParser(0, 0, params, t.source, t.movies, t.seasons, t.relations, t.awards,
+		t.reviews, t.studios, t.premieres, t.frontier, t.visited, t.failed)
+
+	return t
+}
@@ -0,0 +1,93 @@
+package parser
+
+import (
+	"Kinopoisk-Parser/internal/domain"
+	"context"
+	"fmt"
+	"github.com/sirupsen/logrus"
+	"golang.org/x/time/rate"
+	"time"
+)
+
+type RequestGate struct {
+	Limiter    *rate.Limiter
+	DailyLimit uint64
+	State      domain.FrontierRepository
+}
+
+func NewRequestGate(requestsPerSecond float64, burst, timeForSleep, dailyLimit uint64,
+	state domain.FrontierRepository) *RequestGate {
+	return &RequestGate{
+		Limiter:    newLimiter(requestsPerSecond, burst, timeForSleep),
+		DailyLimit: dailyLimit,
+		State:      state,
+	}
+}
+
+func newLimiter(requestsPerSecond float64, burst, timeForSleep uint64) *rate.Limiter {
+	if burst == 0 {
+		burst = 1
+	}
+
+	switch {
+	case requestsPerSecond > 0:
+		return rate.NewLimiter(rate.Limit(requestsPerSecond), int(burst))
+	case timeForSleep > 0:
+		return rate.NewLimiter(rate.Every(time.Second*time.Duration(timeForSleep)), 1)
+	default:
+		return rate.NewLimiter(rate.Inf, int(burst))
+	}
+}
+
+func (g *RequestGate) Wait(ctx context.Context) error {
+	err := g.Limiter.Wait(ctx)
+	if err != nil {
+		return err
+	}
+
+	return g.countRequest(ctx)
+}
+
+func (g *RequestGate) countRequest(ctx context.Context) error {
+	if g.DailyLimit == 0 {
+		return nil
+	}
+
+	key := fmt.Sprintf("%s_%s", domain.DailyRequestsKey, time.Now().Format("2006-01-02"))
+	used, err := g.State.IncState(ctx, key, 1)
+	if err != nil {
+		logrus.Errorf("Gate error count request: %v", err)
+		return err
+	}
+
+	if used > g.DailyLimit {
+		return domain.RequestBudgetExhausted
+	}
+
+	return nil
+}
+
+func (g *RequestGate) SetRate(requestsPerSecond float64, burst uint64) {
+	if burst == 0 {
+		burst = 1
+	}
+
+	limit := rate.Limit(requestsPerSecond)
+	if requestsPerSecond <= 0 {
+		limit = rate.Inf
+	}
+
+	g.Limiter.SetLimit(limit)
+	g.Limiter.SetBurst(int(burst))
+}
+
+// Rate reports the current limit, an unlimited gate is reported as zero
+// requests per second.
+func (g *RequestGate) Rate() (float64, uint64) {
+	limit := g.Limiter.Limit()
+	if limit == rate.Inf {
+		return 0, uint64(g.Limiter.Burst())
+	}
+
+	return float64(limit), uint64(g.Limiter.Burst())
+}
@@ -0,0 +1,98 @@
+package parser
+
+import (
+	"Kinopoisk-Parser/internal/domain"
+	"context"
+	"golang.org/x/time/rate"
+	"testing"
+	"time"
+)
+
+func newTestGate(requestsPerSecond float64, burst, timeForSleep, dailyLimit uint64) (*RequestGate, *fakeFrontier) {
+	state := &fakeFrontier{claimed: map[visitedKey]bool{}, state: map[string]uint64{}}
+
+	return NewRequestGate(requestsPerSecond, burst, timeForSleep, dailyLimit, state), state
+}
+
+func TestNewLimiter(t *testing.T) {
+	tests := []struct {
+		name              string
+		requestsPerSecond float64
+		burst             uint64
+		timeForSleep      uint64
+		limit             rate.Limit
+		expectedBurst     int
+	}{
+		{"requests per second", 4, 2, 10, 4, 2},
+		{"sleep between requests", 0, 3, 2, rate.Every(2 * time.Second), 1},
+		{"unlimited", 0, 0, 0, rate.Inf, 1},
+	}
+
+	for _, test := range tests {
+		limiter := newLimiter(test.requestsPerSecond, test.burst, test.timeForSleep)
+		if limiter.Limit() != test.limit || limiter.Burst() != test.expectedBurst {
+			t.Errorf("%s: limit = %v, burst = %d", test.name, limiter.Limit(), limiter.Burst())
+		}
+	}
+}
+
+func TestGateDailyLimit(t *testing.T) {
+	gate, state := newTestGate(0, 0, 0, 2)
+	ctx := context.Background()
+
+	for i := 0; i < 2; i++ {
+		if err := gate.Wait(ctx); err != nil {
+			t.Fatalf("request %d: %v", i+1, err)
+		}
+	}
+
+	if err := gate.Wait(ctx); err != domain.RequestBudgetExhausted {
+		t.Errorf("request over the daily limit: err = %v", err)
+	}
+
+	key := domain.DailyRequestsKey + "_" + time.Now().Format("2006-01-02")
+	if state.state[key] != 3 {
+		t.Errorf("%s = %d", key, state.state[key])
+	}
+}
+
+func TestGateWithoutDailyLimit(t *testing.T) {
+	gate, state := newTestGate(0, 0, 0, 0)
+
+	for i := 0; i < 3; i++ {
+		if err := gate.Wait(context.Background()); err != nil {
+			t.Fatalf("request %d: %v", i+1, err)
+		}
+	}
+
+	if len(state.state) != 0 {
+		t.Errorf("requests are counted without a daily limit: %v", state.state)
+	}
+}
+
+func TestGateWaitCancelled(t *testing.T) {
+	gate, state := newTestGate(0.001, 1, 0, 10)
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	if err := gate.Wait(ctx); err == nil {
+		t.Error("wait succeeds with a cancelled context")
+	}
+	if len(state.state) != 0 {
+		t.Errorf("cancelled request is counted: %v", state.state)
+	}
+}
+
+func TestGateSetRate(t *testing.T) {
+	gate, _ := newTestGate(1, 1, 0, 0)
+
+	gate.SetRate(5, 3)
+	if limit, burst := gate.Rate(); limit != 5 || burst != 3 {
+		t.Errorf("rate = %v, burst = %d", limit, burst)
+	}
+
+	gate.SetRate(0, 0)
+	if limit, burst := gate.Rate(); limit != 0 || burst != 1 {
+		t.Errorf("unlimited rate = %v, burst = %d", limit, burst)
+	}
+}
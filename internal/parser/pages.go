@@ -0,0 +1,112 @@
+package parser
+
+import (
+	"Kinopoisk-Parser/config"
+	"Kinopoisk-Parser/internal/domain"
+	"context"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	IDsMode   = "ids"
+	PagesMode = "pages"
+	SeedsMode = "seeds"
+)
+
+const (
+	startPage        uint64 = 1
+	defaultPageLimit uint64 = 250
+)
+
+func newFilter(params config.FilterParams) domain.MovieFilter {
+	return domain.MovieFilter{
+		YearFrom:  params.YearFrom,
+		YearTo:    params.YearTo,
+		Types:     params.Types,
+		MinRating: params.MinRating,
+		MinLength: params.MinLength,
+		Countries: params.Countries,
+	}
+}
+
+func (p *Parser) nextPage(ctx context.Context) (uint64, bool) {
+	p.pageMutex.Lock()
+	defer p.pageMutex.Unlock()
+
+	if p.pagesDone {
+		return 0, false
+	}
+
+	page := p.page
+	p.page += 1
+	p.pagesInFlight[page] = true
+
+	return page, true
+}
+
+// savePage saves the page index once the page's movies are enqueued: the
+// index points at the first page still being fetched, so a page interrupted
+// by a stop is fetched again after a restart.
+func (p *Parser) savePage(ctx context.Context, page uint64) {
+	p.pageMutex.Lock()
+	defer p.pageMutex.Unlock()
+
+	delete(p.pagesInFlight, page)
+
+	index := p.page
+	for inFlight := range p.pagesInFlight {
+		if inFlight < index {
+			index = inFlight
+		}
+	}
+
+	err := p.Frontier.SetState(ctx, domain.PageIndexKey, index)
+	if err != nil {
+		logrus.Errorf("Parser error save page: %v", err)
+	}
+}
+
+func (p *Parser) finishPages(page uint64) {
+	p.pageMutex.Lock()
+	defer p.pageMutex.Unlock()
+
+	if !p.pagesDone {
+		logrus.Infof("Parser reached the last page = %d, switching to the person graph", page)
+	}
+	p.pagesDone = true
+}
+
+func (p *Parser) parsePage(ctx context.Context, page uint64) error {
+	logrus.Infof("Parse movies page = %d", page)
+
+	movies, err := p.Source.GetMovies(ctx, page, p.PageLimit, p.Filter)
+	if err != nil {
+		logrus.Errorf("Parser error page fetch: %v", err)
+		return err
+	}
+
+	if movies.Last(page) {
+		p.finishPages(page)
+	}
+
+	for _, movie := range movies.Docs {
+		item := domain.FrontierItem{ID: uint64(movie.Id), Kind: domain.MovieItem}
+
+		firstVisit, err := p.Visited.Visit(ctx, item.Kind, item.ID)
+		if err != nil {
+			logrus.Errorf("Parser error visit: %v", err)
+			return err
+		}
+
+		if !firstVisit {
+			continue
+		}
+
+		err = p.finishItem(ctx, item, p.storeMovie(movie, item))
+		if err != nil {
+			logrus.Error(err)
+		}
+	}
+
+	return nil
+}
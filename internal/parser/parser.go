@@ -1,183 +1,659 @@
 package parser
 
 import (
+	"Kinopoisk-Parser/config"
 	"Kinopoisk-Parser/internal/domain"
 	"context"
-	"encoding/json"
-	"fmt"
 	"github.com/sirupsen/logrus"
-	"io"
-	"net/http"
+	"strings"
+	"sync"
 	"time"
 )
 
+const startIndex uint64 = 1000
+
 type Parser struct {
-	MaxMovies       uint64
-	TimeForSleep    uint64
-	MovieURL        string
-	PersonURL       string
-	Token           string
-	Usecase         domain.MovieUsecase
-	ListOfActorsIDs []int
+	MaxMovies    uint64
+	TimeForSleep uint64
+	Workers      uint64
+	MaxRunTime   time.Duration
+	Mode         string
+	Strategy     string
+	PageLimit    uint64
+	RefreshAfter time.Duration
+	FetchSeasons bool
+	FetchAwards  bool
+	FetchReviews bool
+	FetchStudios bool
+	Filter       domain.MovieFilter
+	StoreScope   domain.MovieFilter
+	ExpandScope  domain.MovieFilter
+	Source       domain.MovieSource
+	Usecase      domain.MovieUsecase
+	Seasons      domain.SeasonUsecase
+	Relations    domain.RelationUsecase
+	Awards       domain.AwardUsecase
+	Reviews      domain.ReviewUsecase
+	Studios      domain.StudioUsecase
+	Premieres    domain.PremiereUsecase
+	Frontier     domain.FrontierRepository
+	Visited      domain.VisitedRepository
+	Failed       domain.FailedRepository
+
+	indexMutex    sync.Mutex
+	index         uint64
+	pageMutex     sync.Mutex
+	page          uint64
+	pagesInFlight map[uint64]bool
+	pagesDone     bool
+	storedMovies  uint64
+	stopOnce      sync.Once
+	stopReason    StopReason
+	cancel        context.CancelFunc
+
+	refreshMutex sync.Mutex
+	staleItems   []domain.FrontierItem
+	staleKind    uint64
+	staleCursor  uint64
+	staleBefore  time.Time
+
+	pauseMutex       sync.Mutex
+	resumed          chan struct{}
+	statsMutex       sync.Mutex
+	processedMovies  uint64
+	processedPersons uint64
+	processedPages   uint64
+	processedSeasons uint64
+	processedAwards  uint64
+	processedReviews uint64
+	processedStudios uint64
+	errorCount       uint64
+	changedFields    uint64
+	lastError        string
+	current          map[int]domain.FrontierItem
 }
 
-func NewParser(maxMovies, TimeForSleep uint64, movieURL, personURL, token string, usecase domain.MovieUsecase) Parser {
-	return Parser{
-		MaxMovies:       maxMovies,
-		MovieURL:        movieURL,
-		PersonURL:       personURL,
-		Token:           token,
-		TimeForSleep:    TimeForSleep,
-		Usecase:         usecase,
-		ListOfActorsIDs: []int{},
+func NewParser(maxMovies, TimeForSleep uint64, params config.CrawlerParams, source domain.MovieSource,
+	usecase domain.MovieUsecase, seasons domain.SeasonUsecase, relations domain.RelationUsecase,
+	awards domain.AwardUsecase, reviews domain.ReviewUsecase, studios domain.StudioUsecase,
+	premieres domain.PremiereUsecase, frontier domain.FrontierRepository, visited domain.VisitedRepository, failed domain.FailedRepository) *Parser {
+	workers := params.Workers
+	if workers == 0 {
+		workers = 1
+	}
+
+	pageLimit := params.PageLimit
+	if pageLimit == 0 {
+		pageLimit = defaultPageLimit
+	}
+
+	return &Parser{
+		MaxMovies:    maxMovies,
+		TimeForSleep: TimeForSleep,
+		Workers:      workers,
+		MaxRunTime:   time.Second * time.Duration(params.MaxRunSeconds),
+		Mode:         params.Mode,
+		Strategy:     params.Strategy,
+		PageLimit:    pageLimit,
+		RefreshAfter: time.Hour * time.Duration(params.RefreshAfterHours),
+		FetchSeasons: params.FetchSeasons,
+		FetchAwards:  params.FetchAwards,
+		FetchReviews: params.FetchReviews,
+		FetchStudios: params.FetchStudios,
+		Filter:       newFilter(params.PageFilter),
+		StoreScope:   newFilter(params.Scope.Store),
+		ExpandScope:  newFilter(params.Scope.Expand),
+		Source:       source,
+		Usecase:      usecase,
+		Seasons:      seasons,
+		Relations:    relations,
+		Awards:       awards,
+		Reviews:      reviews,
+		Studios:      studios,
+		Premieres:    premieres,
+		Frontier:     frontier,
+		Visited:      visited,
+		Failed:       failed,
+
+		pagesInFlight: map[uint64]bool{},
 	}
 }
 
-func (p *Parser) Parse() {
-	index := 1000
-	for {
-		for len(p.ListOfActorsIDs) > 0 {
-			err := p.parsePerson(p.ListOfActorsIDs[0])
-			if err != nil {
-				logrus.Error(err)
+// Parse runs the workers until a stop condition is met or parent is cancelled.
+// A parser runs one crawl at a time, but may be started again after it stops.
+func (p *Parser) Parse(parent context.Context) StopReason {
+	var (
+		ctx    context.Context
+		cancel context.CancelFunc
+	)
+	if p.MaxRunTime > 0 {
+		ctx, cancel = context.WithTimeout(parent, p.MaxRunTime)
+	} else {
+		ctx, cancel = context.WithCancel(parent)
+	}
+	p.cancel = cancel
+	p.stopOnce = sync.Once{}
+	p.stopReason = ""
+	p.resetStats()
+	defer cancel()
+
+	// Leases taken before this crawl started were left by a crawl that stopped
+	// without finishing the items, so they are handed out again.
+	released, err := p.Frontier.ReleaseClaimed(ctx, time.Now())
+	if err != nil {
+		logrus.Errorf("Parser error release claimed: %v", err)
+	}
+	if released > 0 {
+		logrus.Infof("Parser requeued %d items left by the previous crawl", released)
+	}
+
+	p.index = p.loadState(ctx, domain.SequentialIndexKey, startIndex)
+	p.page = p.loadState(ctx, domain.PageIndexKey, startPage)
+	p.pagesInFlight = map[uint64]bool{}
+	p.pagesDone = p.Mode != PagesMode
+	p.storedMovies = p.loadStoredMovies(ctx)
+	p.checkStoreScope(ctx)
+	logrus.Infof("Parser starts from index = %d with %d workers, %d movies stored", p.index, p.Workers, p.storedMovies)
+
+	if p.Mode != RefreshMode && p.MaxMovies > 0 && p.storedMovies >= p.MaxMovies {
+		p.stop(StopMaxMovies)
+	}
+
+	var wg sync.WaitGroup
+	for i := 0; i < int(p.Workers); i++ {
+		wg.Add(1)
+		go func(worker int) {
+			defer wg.Done()
+			p.work(ctx, worker)
+		}(i)
+	}
+
+	wg.Wait()
+
+	reason := p.reason(ctx)
+	logrus.Infof("Parser stopped, reason = %s", reason)
+
+	return reason
+}
+
+func (p *Parser) work(ctx context.Context, worker int) {
+	defer p.clearCurrent(worker)
+
+	for ctx.Err() == nil {
+		p.waitIfPaused(ctx)
+		if ctx.Err() != nil {
+			return
+		}
+
+		item, err := p.nextItem(ctx)
+		if err != nil {
+			if err != domain.FrontierEmpty {
+				logrus.Errorf("Parser error frontier pop: %v", err)
 			}
-			p.ListOfActorsIDs = p.ListOfActorsIDs[1:]
+			p.idle(ctx)
+			continue
+		}
+
+		p.setCurrent(worker, item)
+		err = p.parseItem(ctx, item)
+		p.clearCurrent(worker)
+		switch err {
+		case domain.RequestBudgetExhausted:
+			p.stop(StopRequestBudget)
+		case domain.TokensExhausted:
+			p.stop(StopTokens)
 		}
-		err := p.parseMovie(index)
 		if err != nil {
 			logrus.Error(err)
 		}
-		index += 1
+
+		// A page is fetched again from the saved page index.
+		if ctx.Err() != nil && err != nil && item.Kind != domain.PageItem {
+			p.requeue(item)
+		}
+	}
+}
+
+func (p *Parser) idle(ctx context.Context) {
+	select {
+	case <-ctx.Done():
+	case <-time.After(time.Second * time.Duration(p.TimeForSleep)):
+	}
+}
+
+func (p *Parser) nextItem(ctx context.Context) (domain.FrontierItem, error) {
+	if p.Mode == RefreshMode {
+		return p.nextStale(ctx)
+	}
+
+	page, ok := p.nextPage(ctx)
+	if ok {
+		return domain.FrontierItem{ID: page, Kind: domain.PageItem}, nil
+	}
+
+	item, err := p.Frontier.Pop(ctx, p.Strategy)
+	switch err {
+	case nil:
+		return item, nil
+	case domain.FrontierEmpty:
+		if p.Mode == SeedsMode {
+			return domain.FrontierItem{}, err
+		}
+		return domain.FrontierItem{ID: p.nextIndex(ctx), Kind: domain.MovieItem}, nil
+	default:
+		return domain.FrontierItem{}, err
 	}
 }
 
-func (p *Parser) sendRequest(req *http.Request) (*http.Response, error) {
-	time.Sleep(time.Second * time.Duration(p.TimeForSleep))
-	client := &http.Client{}
-	return client.Do(req)
+func (p *Parser) requeue(item domain.FrontierItem) {
+	err := p.Frontier.Release(context.Background(), item)
+	if err != nil {
+		logrus.Errorf("Parser error requeue: %v", err)
+	}
 }
 
-func (p *Parser) parsePerson(index int) error {
-	logrus.Infof("Parse person with index = %d", index)
-	URL := fmt.Sprintf("%s/%d", p.PersonURL, index)
-	req, err := http.NewRequest("GET", URL, nil)
+// done removes a handled item from the frontier, ending its lease.
+func (p *Parser) done(item domain.FrontierItem) {
+	err := p.Frontier.Done(context.Background(), item.Kind, item.ID)
 	if err != nil {
-		logrus.Error(err)
-		return err
+		logrus.Errorf("Parser error frontier done: %v", err)
 	}
+}
 
-	req.Header.Add("X-API-KEY", p.Token)
-	resp, err := p.sendRequest(req)
+func (p *Parser) nextIndex(ctx context.Context) uint64 {
+	p.indexMutex.Lock()
+	defer p.indexMutex.Unlock()
 
+	index := p.index
+	p.index += 1
+
+	err := p.Frontier.SetState(ctx, domain.SequentialIndexKey, p.index)
 	if err != nil {
-		logrus.Error(err)
-		return err
+		logrus.Errorf("Parser error save index: %v", err)
+	}
+
+	return index
+}
+
+func (p *Parser) loadState(ctx context.Context, key string, defaultValue uint64) uint64 {
+	for {
+		value, err := p.Frontier.GetState(ctx, key)
+		switch err {
+		case nil:
+			return value
+		case domain.StateNotFound:
+			return defaultValue
+		default:
+			logrus.Errorf("Parser error load %s: %v", key, err)
+			if ctx.Err() != nil {
+				return defaultValue
+			}
+			time.Sleep(time.Second * time.Duration(p.TimeForSleep))
+		}
+	}
+}
+
+func (p *Parser) parseItem(ctx context.Context, item domain.FrontierItem) error {
+	if item.Kind == domain.PageItem {
+		err := p.parsePage(ctx, item.ID)
+		if !interrupted(ctx, err) {
+			p.savePage(ctx, item.ID)
+		}
+		return p.finishItem(ctx, item, err)
+	}
+
+	if p.Mode == RefreshMode {
+		return p.finishItem(ctx, item, p.refreshItem(ctx, item))
 	}
 
-	statusCode := resp.StatusCode
-	if statusCode != http.StatusOK {
-		logrus.Errorf("Request status code = %d", statusCode)
+	firstVisit, err := p.Visited.Visit(ctx, item.Kind, item.ID)
+	if err != nil {
+		logrus.Errorf("Parser error visit: %v", err)
 		return err
 	}
 
-	body, err := io.ReadAll(resp.Body)
+	if !firstVisit {
+		p.countSavedFetch(item)
+		p.done(item)
+		return nil
+	}
+
+	switch item.Kind {
+	case domain.PersonItem:
+		err = p.parsePerson(ctx, item)
+	case domain.SeasonItem:
+		err = p.parseSeasons(ctx, item)
+	case domain.MovieAwardsItem:
+		err = p.parseMovieAwards(ctx, item)
+	case domain.PersonAwardsItem:
+		err = p.parsePersonAwards(ctx, item)
+	case domain.ReviewItem:
+		err = p.parseReviews(ctx, item)
+	case domain.StudioItem:
+		err = p.parseStudios(ctx, item)
+	default:
+		err = p.parseMovie(ctx, item)
+	}
+
+	return p.finishItem(ctx, item, err)
+}
+
+func (p *Parser) finishItem(ctx context.Context, item domain.FrontierItem, err error) error {
+	if err == domain.UpstreamNotFound {
+		logrus.Infof("Item (kind = %d, id = %d) not found upstream", item.Kind, item.ID)
+		p.done(item)
+		p.countProcessed(item)
+		return nil
+	}
+
 	if err != nil {
-		logrus.Errorf("Parser error person read all: %v", err)
+		if ctx.Err() == nil {
+			p.countError(err)
+		}
+
+		// A refreshed item is already stored, so it stays visited when the
+		// refresh fails.
+		if p.Mode != RefreshMode {
+			forgetErr := p.Visited.Forget(context.Background(), item.Kind, item.ID)
+			if forgetErr != nil {
+				logrus.Errorf("Parser error forget: %v", forgetErr)
+			}
+		}
+
+		// An item that is not marked failed keeps its lease and is requeued by
+		// the worker, a failed one is replayed from the dead-letter store.
+		if !interrupted(ctx, err) {
+			p.markFailed(item, err)
+			p.done(item)
+		}
+
 		return err
 	}
 
-	var person domain.PersonDTO
+	p.done(item)
+
+	err = p.Failed.Delete(context.Background(), item.Kind, item.ID)
+	if err != nil {
+		logrus.Errorf("Parser error delete failed: %v", err)
+	}
+
+	p.countProcessed(item)
+
+	return nil
+}
+
+// interrupted reports whether the item failed because the crawl is stopping,
+// not because of the item itself.
+func interrupted(ctx context.Context, err error) bool {
+	return ctx.Err() != nil || err == domain.RequestBudgetExhausted || err == domain.TokensExhausted
+}
+
+func (p *Parser) markFailed(item domain.FrontierItem, reason error) {
+	err := p.Failed.Add(context.Background(), item.Kind, item.ID, reason.Error())
+	if err != nil {
+		logrus.Errorf("Parser error mark failed: %v", err)
+	}
+}
+
+func (p *Parser) enqueue(kind uint64, id int, priority float64, parent domain.FrontierItem) {
+	ctx := context.Background()
+	item := domain.FrontierItem{
+		ID:       uint64(id),
+		Kind:     kind,
+		Priority: priority,
+		Depth:    parent.Depth + 1,
+		MaxDepth: parent.MaxDepth,
+	}
+
+	if item.MaxDepth > 0 && item.Depth > item.MaxDepth {
+		return
+	}
+
+	visited, err := p.Visited.IsVisited(ctx, kind, item.ID)
+	if err != nil {
+		logrus.Errorf("Parser error is visited: %v", err)
+	}
+
+	if visited {
+		p.countSavedFetch(item)
+		return
+	}
+
+	err = p.Frontier.Push(ctx, item)
+	if err != nil {
+		logrus.Errorf("Parser error frontier push: %v", err)
+	}
+}
+
+// countSavedFetch counts a skipped movie or person fetch, detail items are
+// not movie fetches and are left out.
+func (p *Parser) countSavedFetch(item domain.FrontierItem) {
+	var key string
+	switch item.Kind {
+	case domain.MovieItem:
+		key = domain.SavedMovieFetchesKey
+	case domain.PersonItem:
+		key = domain.SavedPersonFetchesKey
+	default:
+		return
+	}
+
+	saved, err := p.Frontier.IncState(context.Background(), key, 1)
+	if err != nil {
+		logrus.Errorf("Parser error count saved fetch: %v", err)
+		return
+	}
 
-	err = json.Unmarshal(body, &person)
+	logrus.Infof("Skip visited item (kind = %d, id = %d), %s = %d", item.Kind, item.ID, key, saved)
+}
+
+func (p *Parser) parsePerson(ctx context.Context, item domain.FrontierItem) error {
+	logrus.Infof("Parse person with index = %d", item.ID)
+	person, err := p.Source.GetPerson(ctx, item.ID)
+	if err != nil {
+		logrus.Errorf("Parser error person fetch: %v", err)
+		return err
+	}
+
+	_, err = p.Usecase.SavePerson(context.Background(), newPerson(person))
 	if err != nil {
-		logrus.Errorf("Parser error person unmarshal: %v", err)
 		return err
 	}
 
+	if p.FetchAwards {
+		p.enqueueDetail(domain.PersonAwardsItem, person.Id, item.Priority, item)
+	}
+
 	for _, hisMovie := range person.Movies {
-		err := p.parseMovie(hisMovie.Id)
-		if err != nil {
-			logrus.Error(err)
-			return err
-		}
+		p.enqueue(domain.MovieItem, hisMovie.Id, p.moviePriority(hisMovie.Rating, hisMovie.Votes.Kp), item)
 	}
 
 	return nil
 }
 
-func (p *Parser) parseMovie(index int) error {
-	logrus.Infof("Parse movie with index = %d", index)
+func newPerson(person domain.PersonDTO) *domain.Person {
+	birthplace := make([]string, 0, len(person.BirthPlace))
+	for _, place := range person.BirthPlace {
+		birthplace = append(birthplace, place.Value)
+	}
+
+	return &domain.Person{
+		ID:         uint64(person.Id),
+		FullName:   person.Name,
+		EnName:     person.EnName,
+		Photo:      person.Photo,
+		Sex:        person.Sex,
+		Height:     uint64(person.Growth),
+		Age:        uint64(person.Age),
+		Birthday:   person.Birthday,
+		Death:      person.Death,
+		Birthplace: strings.Join(birthplace, ", "),
+		FetchedAt:  time.Now(),
+	}
+}
+
+func (p *Parser) parseMovie(ctx context.Context, item domain.FrontierItem) error {
+	logrus.Infof("Parse movie with index = %d", item.ID)
 
-	URL := fmt.Sprintf("%s/%d", p.MovieURL, index)
-	req, err := http.NewRequest("GET", URL, nil)
+	movie, err := p.Source.GetMovie(ctx, item.ID)
 	if err != nil {
-		logrus.Error(err)
+		logrus.Errorf("Parser error movie fetch: %v", err)
 		return err
 	}
 
-	req.Header.Add("X-API-KEY", p.Token)
-	resp, err := p.sendRequest(req)
+	return p.storeMovie(movie, item)
+}
+
+func (p *Parser) storeMovie(movie domain.MovieDTO, item domain.FrontierItem) error {
+	if inScope(p.ExpandScope, movie) {
+		for _, person := range movie.Persons {
+			p.enqueue(domain.PersonItem, person.Id, p.castPriority(movie), item)
+		}
+
+		p.enqueueRelated(movie, item)
+	}
+
+	if !inScope(p.StoreScope, movie) {
+		logrus.Infof("Movie with id = %d is out of scope, skip storing", movie.Id)
+		// Stays visited until the store scope changes, see checkStoreScope.
+		err := p.Visited.Reject(context.Background(), domain.MovieItem, uint64(movie.Id))
+		if err != nil {
+			logrus.Errorf("Parser error reject: %v", err)
+		}
+		return nil
+	}
+
+	result := newMovie(movie)
 
+	added, err := p.addMovie(&result)
 	if err != nil {
-		logrus.Error(err)
 		return err
 	}
 
-	statusCode := resp.StatusCode
-	if statusCode != http.StatusOK {
-		logrus.Errorf("Request status code = %d", statusCode)
-		return err
+	if added {
+		p.countStoredMovie()
 	}
 
-	body, err := io.ReadAll(resp.Body)
+	err = p.saveRelations(movie)
 	if err != nil {
-		logrus.Errorf("Parser error movie read all: %v", err)
 		return err
 	}
 
-	var movie domain.MovieDTO
-
-	err = json.Unmarshal(body, &movie)
+	err = p.savePremieres(movie)
 	if err != nil {
-		logrus.Errorf("Parser error movie unmarshal: %v", err)
 		return err
 	}
 
+	priority := p.moviePriority(movie.Rating.Imdb, movie.Votes.Kp)
+
+	if p.FetchSeasons && movie.IsSeries {
+		p.enqueueDetail(domain.SeasonItem, movie.Id, priority, item)
+	}
+
+	if p.FetchAwards {
+		p.enqueueDetail(domain.MovieAwardsItem, movie.Id, priority, item)
+	}
+
+	if p.FetchReviews && movie.ReviewInfo.Count > 0 {
+		p.enqueueDetail(domain.ReviewItem, movie.Id, priority, item)
+	}
+
+	if p.FetchStudios {
+		p.enqueueDetail(domain.StudioItem, movie.Id, priority, item)
+	}
+
+	return nil
+}
+
+// addMovie stores a new movie, or updates it when it is already stored, as
+// happens when a seed is crawled again. It reports whether the movie is new.
+func (p *Parser) addMovie(movie *domain.Movie) (bool, error) {
+	_, err := p.Usecase.Update(context.Background(), movie)
+	if err != domain.MovieNotFound {
+		return false, err
+	}
+
+	return true, p.Usecase.Add(context.Background(), movie)
+}
+
+func newMovie(movie domain.MovieDTO) domain.Movie {
 	result := domain.Movie{
 		BaseInfo: domain.MovieBaseInfo{
-			ID:       uint64(movie.Id),
-			Title:    movie.Name,
-			Year:     uint64(movie.Year),
-			Tagline:  movie.Slogan,
-			Duration: uint64(movie.MovieLength),
-			Rating:   movie.Rating.Imdb,
-			Budget:   uint64(movie.Budget.Value),
-			Gross:    uint64(movie.Fees.World.Value),
+			ID:               uint64(movie.Id),
+			Title:            movie.Name,
+			AlternativeName:  movie.AlternativeName,
+			EnName:           movie.EnName,
+			Type:             movie.Type,
+			IsSeries:         movie.IsSeries,
+			Status:           movie.Status,
+			Year:             uint64(movie.Year),
+			Tagline:          movie.Slogan,
+			Description:      movie.Description,
+			ShortDescription: movie.ShortDescription,
+			Duration:         uint64(movie.MovieLength),
+			AgeRating:        uint64(movie.AgeRating),
+			Rating:           movie.Rating.Imdb,
+			Ratings: domain.MovieRatings{
+				Kp:                 movie.Rating.Kp,
+				Imdb:               movie.Rating.Imdb,
+				Tmdb:               movie.Rating.Tmdb,
+				FilmCritics:        movie.Rating.FilmCritics,
+				RussianFilmCritics: movie.Rating.RussianFilmCritics,
+				Await:              movie.Rating.Await,
+			},
+			ReviewInfo: domain.MovieReviewInfo{
+				Count:      uint64(movie.ReviewInfo.Count),
+				Positive:   uint64(movie.ReviewInfo.Positive),
+				Percentage: movie.ReviewInfo.Percentage,
+			},
+			Budget:           uint64(movie.Budget.Value),
+			BudgetCurrency:   movie.Budget.Currency,
+			Gross:            uint64(movie.Fees.World.Value),
+			GrossCurrency:    movie.Fees.World.Currency,
+			GrossUsa:         uint64(movie.Fees.Usa.Value),
+			GrossUsaCurrency: movie.Fees.Usa.Currency,
+			GrossRus:         uint64(movie.Fees.Russia.Value),
+			GrossRusCurrency: movie.Fees.Russia.Currency,
+			Genres:           []string{},
+			Countries:        []string{},
+			FetchedAt:        time.Now(),
 		},
-		Producers: []domain.Person{},
-		Directors: []domain.Person{},
-		Actors:    []domain.Person{},
-		Writers:   []domain.Person{},
+		Producers: []domain.Credit{},
+		Directors: []domain.Credit{},
+		Actors:    []domain.Credit{},
+		Writers:   []domain.Credit{},
+	}
+
+	for _, genre := range movie.Genres {
+		result.BaseInfo.Genres = append(result.BaseInfo.Genres, genre.Name)
+	}
+
+	for _, country := range movie.Countries {
+		result.BaseInfo.Countries = append(result.BaseInfo.Countries, country.Name)
 	}
 
 	for _, person := range movie.Persons {
-		p.ListOfActorsIDs = append(p.ListOfActorsIDs, person.Id)
-		tmpPerson := domain.Person{
-			ID:       uint64(person.Id),
-			FullName: person.Name,
+		tmpPerson := domain.Credit{
+			Person: domain.Person{
+				ID:       uint64(person.Id),
+				FullName: person.Name,
+				EnName:   person.EnName,
+				Photo:    person.Photo,
+			},
+			Character: person.Description,
 		}
 
-		switch person.EnProfession {
-		case "producer":
-			result.Producers = append(result.Producers, tmpPerson)
-		case "director":
-			result.Directors = append(result.Directors, tmpPerson)
-		case "writer":
-			result.Writers = append(result.Writers, tmpPerson)
-		case "actor":
-			result.Actors = append(result.Actors, tmpPerson)
+		role, err := domain.RoleByEnName(person.EnProfession)
+		if err != nil {
+			logrus.Infof("Skip person with id = %d, unknown profession %q", person.Id, person.EnProfession)
+			continue
 		}
+
+		// Persons come in billing order, so the position is the order within the role.
+		credits := result.Credits(role)
+		tmpPerson.Position = uint64(len(credits) + 1)
+		result.SetCredits(role, append(credits, tmpPerson))
 	}
 
-	return p.Usecase.Add(context.Background(), &result)
+	return result
 }
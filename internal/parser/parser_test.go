@@ -0,0 +1,613 @@
+package parser
+
+import (
+	"Kinopoisk-Parser/config"
+	"Kinopoisk-Parser/internal/domain"
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+func allDetails() config.CrawlerParams {
+	return config.CrawlerParams{
+		FetchSeasons: true,
+		FetchAwards:  true,
+		FetchReviews: true,
+		FetchStudios: true,
+	}
+}
+
+func parse(t *testing.T, p *testParser, kind, id uint64) {
+	t.Helper()
+
+	err := p.parseItem(context.Background(), domain.FrontierItem{ID: id, Kind: kind})
+	if err != nil {
+		t.Fatalf("parse item (kind = %d, id = %d): %v", kind, id, err)
+	}
+}
+
+func date(value string) time.Time {
+	result, err := time.Parse("2006-01-02", value)
+	if err != nil {
+		panic(err)
+	}
+
+	return result
+}
+
+func TestParseMovie(t *testing.T) {
+	p := newTestParser(allDetails())
+
+	parse(t, p, domain.MovieItem, 301)
+
+	movie, ok := p.movies.movies[301]
+	if !ok {
+		t.Fatal("movie 301 is not stored")
+	}
+
+	info := movie.BaseInfo
+	if info.Title != "Матрица" || info.EnName != "The Matrix" || info.Year != 1999 || info.Duration != 136 {
+		t.Errorf("unexpected base info: %+v", info)
+	}
+	if info.Rating != 8.7 || info.Ratings.Kp != 8.5 {
+		t.Errorf("rating = %v, kp rating = %v", info.Rating, info.Ratings.Kp)
+	}
+	if len(info.Genres) != 2 || info.Genres[0] != "фантастика" || len(info.Countries) != 1 {
+		t.Errorf("genres = %v, countries = %v", info.Genres, info.Countries)
+	}
+	if info.ReviewInfo.Count != 3 || info.ReviewInfo.Percentage != "67%" {
+		t.Errorf("review info = %+v", info.ReviewInfo)
+	}
+
+	if len(movie.Actors) != 2 || movie.Actors[0].ID != 7836 || movie.Actors[1].Position != 2 {
+		t.Errorf("actors = %+v", movie.Actors)
+	}
+	if movie.Actors[0].Character != "Neo" {
+		t.Errorf("character = %q", movie.Actors[0].Character)
+	}
+	if len(movie.Directors) != 1 || movie.Directors[0].ID != 22420 {
+		t.Errorf("directors = %+v", movie.Directors)
+	}
+	if composers := movie.Crew["composer"]; len(composers) != 1 || composers[0].ID != 27206 {
+		t.Errorf("crew = %+v", movie.Crew)
+	}
+	if translators := movie.Crew["translator"]; len(translators) != 1 || translators[0].ID != 1988094 {
+		t.Errorf("translator credit is not stored: %+v", movie.Crew)
+	}
+
+	if !p.frontier.has(domain.PersonItem, 7836) || !p.frontier.has(domain.PersonItem, 27206) {
+		t.Error("cast is not enqueued")
+	}
+	if !p.frontier.has(domain.MovieItem, 302) || !p.frontier.has(domain.MovieItem, 447301) {
+		t.Error("related movies are not enqueued")
+	}
+}
+
+func TestNewMovieSkipsUnknownProfession(t *testing.T) {
+	p := newTestParser(config.CrawlerParams{})
+
+	dto, err := p.source.GetMovie(context.Background(), 301)
+	if err != nil {
+		t.Fatalf("get movie: %v", err)
+	}
+	dto.Persons[len(dto.Persons)-1].EnProfession = "stuntman"
+
+	movie := newMovie(dto)
+	if _, ok := movie.Crew["stuntman"]; ok || len(movie.Crew) != 1 {
+		t.Errorf("a person with an unknown profession is stored: %+v", movie.Crew)
+	}
+}
+
+func TestParseMovieBoxOffice(t *testing.T) {
+	p := newTestParser(config.CrawlerParams{})
+
+	parse(t, p, domain.MovieItem, 301)
+
+	info := p.movies.movies[301].BaseInfo
+	if info.Budget != 63000000 || info.BudgetCurrency != "$" {
+		t.Errorf("budget = %d %s", info.Budget, info.BudgetCurrency)
+	}
+	if info.Gross != 467222728 || info.GrossCurrency != "$" {
+		t.Errorf("gross = %d %s", info.Gross, info.GrossCurrency)
+	}
+	if info.GrossUsa != 171479930 || info.GrossUsaCurrency != "$" {
+		t.Errorf("usa gross = %d %s", info.GrossUsa, info.GrossUsaCurrency)
+	}
+	if info.GrossRus != 0 || info.GrossRusCurrency != "" {
+		t.Errorf("russia gross = %d %s", info.GrossRus, info.GrossRusCurrency)
+	}
+}
+
+func TestParseMovieRelations(t *testing.T) {
+	p := newTestParser(config.CrawlerParams{})
+
+	parse(t, p, domain.MovieItem, 301)
+
+	relations := p.relations.relations[301]
+	if len(relations) != 2 {
+		t.Fatalf("relations = %+v, the movie itself and empty ids must be skipped", relations)
+	}
+
+	if relations[0].RelatedID != 302 || relations[0].Type != domain.SequelRelation || relations[0].Year != 2003 {
+		t.Errorf("sequel = %+v", relations[0])
+	}
+	if relations[1].RelatedID != 447301 || relations[1].Type != domain.SimilarRelation ||
+		relations[1].Name != "Начало" {
+		t.Errorf("similar = %+v", relations[1])
+	}
+}
+
+func TestParseMoviePremieres(t *testing.T) {
+	p := newTestParser(config.CrawlerParams{})
+
+	parse(t, p, domain.MovieItem, 301)
+
+	premieres := p.premieres.premieres[301]
+	expected := []domain.Premiere{
+		{MovieID: 301, Country: domain.WorldPremiere, Date: date("1999-03-24")},
+		{MovieID: 301, Country: domain.RussiaPremiere, Date: date("1999-10-14")},
+		{MovieID: 301, Country: domain.DVDPremiere, Date: date("2001-08-16")},
+	}
+
+	if len(premieres) != len(expected) {
+		t.Fatalf("premieres = %+v", premieres)
+	}
+	for i := range expected {
+		if premieres[i].Country != expected[i].Country || !premieres[i].Date.Equal(expected[i].Date) ||
+			premieres[i].MovieID != expected[i].MovieID {
+			t.Errorf("premiere %d = %+v, expected %+v", i, premieres[i], expected[i])
+		}
+	}
+}
+
+func TestParseMovieDetails(t *testing.T) {
+	p := newTestParser(allDetails())
+
+	parse(t, p, domain.MovieItem, 301)
+
+	for _, kind := range []uint64{domain.MovieAwardsItem, domain.ReviewItem, domain.StudioItem} {
+		if !p.frontier.has(kind, 301) {
+			t.Errorf("detail of kind %d is not enqueued", kind)
+		}
+	}
+	if p.frontier.has(domain.SeasonItem, 301) {
+		t.Error("seasons are enqueued for a movie")
+	}
+}
+
+func TestParseMovieWithoutDetails(t *testing.T) {
+	p := newTestParser(config.CrawlerParams{})
+
+	parse(t, p, domain.MovieItem, 301)
+	parse(t, p, domain.MovieItem, 464963)
+
+	for _, kind := range []uint64{domain.SeasonItem, domain.MovieAwardsItem, domain.ReviewItem, domain.StudioItem} {
+		if p.frontier.has(kind, 301) || p.frontier.has(kind, 464963) {
+			t.Errorf("detail of kind %d is enqueued while disabled", kind)
+		}
+	}
+}
+
+func TestParseMovieOutOfStoreScope(t *testing.T) {
+	params := allDetails()
+	params.Scope.Store.Types = []string{"tv-series"}
+	p := newTestParser(params)
+
+	parse(t, p, domain.MovieItem, 301)
+
+	if _, ok := p.movies.movies[301]; ok {
+		t.Error("movie out of the store scope is stored")
+	}
+	if !p.frontier.has(domain.PersonItem, 7836) {
+		t.Error("cast of a movie in the expand scope is not enqueued")
+	}
+	if p.frontier.has(domain.StudioItem, 301) {
+		t.Error("details of a movie that is not stored are enqueued")
+	}
+
+	parse(t, p, domain.MovieItem, 301)
+	if p.source.movieFetches[301] != 1 {
+		t.Errorf("movie fetches = %d, a rejected movie is fetched again", p.source.movieFetches[301])
+	}
+}
+
+func TestStoreScopeChangeForgetsRejected(t *testing.T) {
+	params := allDetails()
+	params.Scope.Store.Types = []string{"tv-series"}
+	p := newTestParser(params)
+	ctx := context.Background()
+
+	p.checkStoreScope(ctx)
+	parse(t, p, domain.MovieItem, 301)
+
+	p.checkStoreScope(ctx)
+	if visited, _ := p.visited.IsVisited(ctx, domain.MovieItem, 301); !visited {
+		t.Fatal("rejected movie is forgotten while the store scope is the same")
+	}
+
+	p.StoreScope.Types = []string{"movie", "tv-series"}
+	p.checkStoreScope(ctx)
+	if visited, _ := p.visited.IsVisited(ctx, domain.MovieItem, 301); visited {
+		t.Fatal("rejected movie stays visited after the store scope changed")
+	}
+
+	parse(t, p, domain.MovieItem, 301)
+	if _, ok := p.movies.movies[301]; !ok {
+		t.Error("movie in the new store scope is not stored")
+	}
+}
+
+func TestParseSeriesWithMinLength(t *testing.T) {
+	params := config.CrawlerParams{}
+	params.Scope.Store.MinLength = 40
+	p := newTestParser(params)
+
+	parse(t, p, domain.MovieItem, 464963)
+
+	if _, ok := p.movies.movies[464963]; !ok {
+		t.Error("series without a length is rejected by min_length")
+	}
+}
+
+func TestParseMovieNotFound(t *testing.T) {
+	p := newTestParser(config.CrawlerParams{})
+
+	parse(t, p, domain.MovieItem, 1)
+
+	if len(p.movies.movies) != 0 {
+		t.Errorf("movies = %+v", p.movies.movies)
+	}
+	if p.processedMovies != 1 {
+		t.Errorf("processed movies = %d, a movie missing upstream counts as processed", p.processedMovies)
+	}
+}
+
+func TestParseVisitedMovie(t *testing.T) {
+	p := newTestParser(config.CrawlerParams{})
+
+	parse(t, p, domain.MovieItem, 301)
+	delete(p.movies.movies, 301)
+	saved := p.frontier.state[domain.SavedMovieFetchesKey]
+	parse(t, p, domain.MovieItem, 301)
+
+	if _, ok := p.movies.movies[301]; ok {
+		t.Error("visited movie is fetched again")
+	}
+	if p.frontier.state[domain.SavedMovieFetchesKey] != saved+1 {
+		t.Errorf("saved fetches = %d, expected %d", p.frontier.state[domain.SavedMovieFetchesKey], saved+1)
+	}
+}
+
+func TestParseVisitedDetail(t *testing.T) {
+	p := newTestParser(allDetails())
+
+	parse(t, p, domain.MovieAwardsItem, 301)
+	parse(t, p, domain.MovieAwardsItem, 301)
+
+	if saved, ok := p.frontier.state[domain.SavedMovieFetchesKey]; ok {
+		t.Errorf("saved movie fetches = %d, a visited detail item is not a movie fetch", saved)
+	}
+}
+
+func TestParseItemRemovesLease(t *testing.T) {
+	p := newTestParser(config.CrawlerParams{})
+	ctx := context.Background()
+
+	_ = p.frontier.Push(ctx, domain.FrontierItem{ID: 301, Kind: domain.MovieItem})
+	item, err := p.frontier.Pop(ctx, "")
+	if err != nil {
+		t.Fatalf("pop: %v", err)
+	}
+	if !p.frontier.has(domain.MovieItem, 301) {
+		t.Fatal("popped item is removed from the frontier before it is parsed")
+	}
+
+	err = p.parseItem(ctx, item)
+	if err != nil {
+		t.Fatalf("parse item: %v", err)
+	}
+	if p.frontier.has(domain.MovieItem, 301) {
+		t.Error("parsed item stays in the frontier")
+	}
+}
+
+func TestParseItemInterruptedKeepsLease(t *testing.T) {
+	p := newTestParser(config.CrawlerParams{})
+	p.source.err = errors.New("connection reset")
+
+	_ = p.frontier.Push(context.Background(), domain.FrontierItem{ID: 301, Kind: domain.MovieItem})
+	item, _ := p.frontier.Pop(context.Background(), "")
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if err := p.parseItem(ctx, item); err == nil {
+		t.Fatal("parse item succeeds with a failing source")
+	}
+
+	if !p.frontier.isClaimed(domain.MovieItem, 301) {
+		t.Error("interrupted item loses its lease")
+	}
+	if _, ok := p.failed.failed[visitedKey{domain.MovieItem, 301}]; ok {
+		t.Error("interrupted item is marked failed")
+	}
+
+	released, _ := p.frontier.ReleaseClaimed(context.Background(), time.Now())
+	if released != 1 || !p.frontier.has(domain.MovieItem, 301) {
+		t.Errorf("released = %d, the lease returns the item to the frontier", released)
+	}
+}
+
+func TestParseItemFailedRemovesLease(t *testing.T) {
+	p := newTestParser(config.CrawlerParams{})
+	p.source.err = errors.New("bad response")
+
+	_ = p.frontier.Push(context.Background(), domain.FrontierItem{ID: 301, Kind: domain.MovieItem})
+	item, _ := p.frontier.Pop(context.Background(), "")
+
+	if err := p.parseItem(context.Background(), item); err == nil {
+		t.Fatal("parse item succeeds with a failing source")
+	}
+
+	if _, ok := p.failed.failed[visitedKey{domain.MovieItem, 301}]; !ok {
+		t.Error("failed item is not marked failed")
+	}
+	if p.frontier.has(domain.MovieItem, 301) {
+		t.Error("failed item stays in the frontier")
+	}
+}
+
+func TestRefreshFailedKeepsVisited(t *testing.T) {
+	p := newTestParser(config.CrawlerParams{})
+	ctx := context.Background()
+
+	parse(t, p, domain.MovieItem, 301)
+
+	p.Mode = RefreshMode
+	p.source.err = errors.New("bad response")
+	if err := p.parseItem(ctx, domain.FrontierItem{ID: 301, Kind: domain.MovieItem}); err == nil {
+		t.Fatal("refresh succeeds with a failing source")
+	}
+
+	if visited, _ := p.visited.IsVisited(ctx, domain.MovieItem, 301); !visited {
+		t.Error("stored movie is forgotten after a failed refresh")
+	}
+	if _, ok := p.failed.failed[visitedKey{domain.MovieItem, 301}]; !ok {
+		t.Error("failed refresh is not marked failed")
+	}
+}
+
+func TestParseSeries(t *testing.T) {
+	p := newTestParser(allDetails())
+
+	parse(t, p, domain.MovieItem, 464963)
+
+	if !p.movies.movies[464963].BaseInfo.IsSeries {
+		t.Error("series is not stored as a series")
+	}
+	if !p.frontier.has(domain.SeasonItem, 464963) {
+		t.Fatal("seasons are not enqueued")
+	}
+	if p.frontier.has(domain.ReviewItem, 464963) {
+		t.Error("reviews are enqueued for a series without reviews")
+	}
+}
+
+func TestParseSeasons(t *testing.T) {
+	p := newTestParser(allDetails())
+
+	parse(t, p, domain.SeasonItem, 464963)
+
+	seasons := p.seasons.seasons
+	if len(seasons) != 2 {
+		t.Fatalf("seasons = %+v", seasons)
+	}
+
+	first := seasons[0]
+	if first.MovieID != 464963 || first.Number != 1 || first.EpisodesCount != 2 || len(first.Episodes) != 2 {
+		t.Errorf("first season = %+v", first)
+	}
+
+	episode := first.Episodes[1]
+	if episode.MovieID != 464963 || episode.SeasonNumber != 1 || episode.EnName != "The Kingsroad" {
+		t.Errorf("episode = %+v", episode)
+	}
+	if episode.AirDate == nil || !episode.AirDate.Equal(date("2011-04-24")) {
+		t.Errorf("episode without an air date must fall back to its date, got %v", episode.AirDate)
+	}
+
+	if seasons[1].EpisodesCount != 1 {
+		t.Errorf("episodes count = %d, must be counted when missing", seasons[1].EpisodesCount)
+	}
+	if p.processedSeasons != 1 {
+		t.Errorf("processed seasons = %d", p.processedSeasons)
+	}
+}
+
+func TestParsePerson(t *testing.T) {
+	params := config.CrawlerParams{FetchAwards: true, Strategy: domain.VotesStrategy}
+	p := newTestParser(params)
+
+	parse(t, p, domain.PersonItem, 7836)
+
+	person, ok := p.movies.persons[7836]
+	if !ok {
+		t.Fatal("person 7836 is not stored")
+	}
+	if person.FullName != "Киану Ривз" || person.Height != 186 || person.Age != 59 {
+		t.Errorf("person = %+v", person)
+	}
+	if person.Birthplace != "Бейрут, Ливан" {
+		t.Errorf("birthplace = %q", person.Birthplace)
+	}
+	if person.Birthday == nil || !person.Birthday.Equal(date("1964-09-02")) || person.Death != nil {
+		t.Errorf("birthday = %v, death = %v", person.Birthday, person.Death)
+	}
+
+	if !p.frontier.has(domain.PersonAwardsItem, 7836) {
+		t.Error("person awards are not enqueued")
+	}
+
+	movie, ok := p.frontier.find(domain.MovieItem, 1048334)
+	if !ok {
+		t.Fatal("filmography is not enqueued")
+	}
+	if movie.Priority != 390000 || movie.Depth != 1 {
+		t.Errorf("filmography item = %+v, the votes strategy ranks by votes", movie)
+	}
+}
+
+func TestParsePage(t *testing.T) {
+	params := allDetails()
+	params.Mode = PagesMode
+	p := newTestParser(params)
+	p.pagesDone = false
+	p.page = startPage
+
+	item, err := p.nextItem(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if item.Kind != domain.PageItem || item.ID != startPage {
+		t.Fatalf("next item = %+v, expected the first page", item)
+	}
+
+	parse(t, p, item.Kind, item.ID)
+
+	movie, ok := p.movies.movies[435]
+	if !ok {
+		t.Fatal("movie from the page is not stored")
+	}
+	if movie.BaseInfo.Title != "Зеленая миля" {
+		t.Errorf("title = %q", movie.BaseInfo.Title)
+	}
+	if !p.pagesDone {
+		t.Error("pages are not finished after the last page")
+	}
+	if visited, _ := p.visited.IsVisited(context.Background(), domain.MovieItem, 435); !visited {
+		t.Error("movie from the page is not marked visited")
+	}
+	if p.processedPages != 1 || p.processedMovies != 1 {
+		t.Errorf("processed pages = %d, movies = %d", p.processedPages, p.processedMovies)
+	}
+	if p.frontier.state[domain.PageIndexKey] != startPage+1 {
+		t.Errorf("page index = %d", p.frontier.state[domain.PageIndexKey])
+	}
+}
+
+func TestParsePageSavesIndexAfterFetch(t *testing.T) {
+	params := config.CrawlerParams{Mode: PagesMode}
+	p := newTestParser(params)
+	p.pagesDone = false
+	p.page = startPage
+	ctx := context.Background()
+
+	first, _ := p.nextPage(ctx)
+	second, _ := p.nextPage(ctx)
+	if _, ok := p.frontier.state[domain.PageIndexKey]; ok {
+		t.Fatal("page index is saved before the page is fetched")
+	}
+
+	parse(t, p, domain.PageItem, second)
+	if p.frontier.state[domain.PageIndexKey] != first {
+		t.Errorf("page index = %d, the first page is still being fetched", p.frontier.state[domain.PageIndexKey])
+	}
+
+	cancelled, cancel := context.WithCancel(ctx)
+	cancel()
+	_ = p.parseItem(cancelled, domain.FrontierItem{ID: first, Kind: domain.PageItem})
+	if p.frontier.state[domain.PageIndexKey] != first {
+		t.Errorf("page index = %d, an interrupted page is fetched again", p.frontier.state[domain.PageIndexKey])
+	}
+}
+
+func TestParseMovieAwards(t *testing.T) {
+	p := newTestParser(allDetails())
+
+	parse(t, p, domain.MovieAwardsItem, 301)
+
+	awards := p.awards.movieAwards[301]
+	if len(awards) != 2 {
+		t.Fatalf("awards = %+v", awards)
+	}
+	if awards[0].Award != "Оскар" || awards[0].Nomination != "Лучший монтаж" || awards[0].Year != 2000 ||
+		!awards[0].Winner {
+		t.Errorf("award = %+v", awards[0])
+	}
+	if awards[1].Winner || awards[1].MovieID != 301 || awards[1].PersonID != 0 {
+		t.Errorf("nomination = %+v", awards[1])
+	}
+}
+
+func TestParsePersonAwards(t *testing.T) {
+	p := newTestParser(allDetails())
+
+	parse(t, p, domain.PersonAwardsItem, 7836)
+
+	awards := p.awards.personAwards[7836]
+	if len(awards) != 1 {
+		t.Fatalf("awards = %+v", awards)
+	}
+	if awards[0].PersonID != 7836 || awards[0].MovieID != 301 || awards[0].Award != "MTV Movie Awards" {
+		t.Errorf("award = %+v, the movie must be taken from the nested movie", awards[0])
+	}
+	if p.processedAwards != 1 {
+		t.Errorf("processed awards = %d", p.processedAwards)
+	}
+}
+
+func TestParseReviews(t *testing.T) {
+	p := newTestParser(allDetails())
+
+	parse(t, p, domain.ReviewItem, 301)
+
+	reviews := p.reviews.reviews[301]
+	if len(reviews) != 3 {
+		t.Fatalf("reviews = %+v", reviews)
+	}
+
+	for i, expected := range []string{domain.PositiveReview, domain.NegativeReview, domain.NeutralReview} {
+		if reviews[i].Type != expected {
+			t.Errorf("review %d type = %q, expected %q", reviews[i].ID, reviews[i].Type, expected)
+		}
+		if reviews[i].MovieID != 301 {
+			t.Errorf("review %d movie = %d", reviews[i].ID, reviews[i].MovieID)
+		}
+	}
+	if reviews[0].Author != "neo_fan" || reviews[0].Date == nil || reviews[2].Date != nil {
+		t.Errorf("reviews = %+v", reviews)
+	}
+}
+
+func TestParseStudios(t *testing.T) {
+	p := newTestParser(allDetails())
+
+	parse(t, p, domain.StudioItem, 301)
+
+	studios := p.studios.studios[301]
+	if len(studios) != 3 {
+		t.Fatalf("studios = %+v", studios)
+	}
+
+	for i, expected := range []string{domain.ProductionStudio, domain.DistributionStudio, "постпродакшн"} {
+		if studios[i].Type != expected {
+			t.Errorf("studio %d type = %q, expected %q", studios[i].ID, studios[i].Type, expected)
+		}
+	}
+	if studios[0].Title != "Warner Bros." || studios[0].SubType != "company" {
+		t.Errorf("studio = %+v", studios[0])
+	}
+}
+
+func TestParseDetailNotFound(t *testing.T) {
+	p := newTestParser(allDetails())
+
+	parse(t, p, domain.SeasonItem, 301)
+
+	if len(p.seasons.seasons) != 0 {
+		t.Errorf("seasons = %+v", p.seasons.seasons)
+	}
+	if len(p.failed.failed) != 0 {
+		t.Errorf("failed = %+v, a detail missing upstream is not a failure", p.failed.failed)
+	}
+}
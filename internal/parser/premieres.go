@@ -0,0 +1,32 @@
+package parser
+
+import (
+	"Kinopoisk-Parser/internal/domain"
+	"context"
+	"time"
+)
+
+func (p *Parser) savePremieres(movie domain.MovieDTO) error {
+	premieres := make([]domain.Premiere, 0, 4)
+	for _, premiere := range []struct {
+		country string
+		date    *time.Time
+	}{
+		{domain.WorldPremiere, movie.Premiere.World},
+		{domain.RussiaPremiere, movie.Premiere.Russia},
+		{domain.DigitalPremiere, movie.Premiere.Digital},
+		{domain.DVDPremiere, movie.Premiere.Dvd},
+	} {
+		if premiere.date == nil {
+			continue
+		}
+
+		premieres = append(premieres, domain.Premiere{
+			MovieID: uint64(movie.Id),
+			Country: premiere.country,
+			Date:    *premiere.date,
+		})
+	}
+
+	return p.Premieres.Save(context.Background(), uint64(movie.Id), premieres)
+}
@@ -0,0 +1,160 @@
+package parser
+
+import (
+	"Kinopoisk-Parser/internal/domain"
+	"context"
+	"github.com/sirupsen/logrus"
+	"sync/atomic"
+	"time"
+)
+
+const RefreshMode = "refresh"
+
+// nextStale hands out stored records fetched before the refresh cutoff, movies
+// first and then persons. When both are exhausted the pass ends and the next
+// one starts with a new cutoff.
+func (p *Parser) nextStale(ctx context.Context) (domain.FrontierItem, error) {
+	p.refreshMutex.Lock()
+	defer p.refreshMutex.Unlock()
+
+	if len(p.staleItems) == 0 {
+		err := p.loadStale(ctx)
+		if err != nil {
+			return domain.FrontierItem{}, err
+		}
+	}
+
+	if len(p.staleItems) == 0 {
+		return domain.FrontierItem{}, domain.FrontierEmpty
+	}
+
+	item := p.staleItems[0]
+	p.staleItems = p.staleItems[1:]
+
+	return item, nil
+}
+
+func (p *Parser) loadStale(ctx context.Context) error {
+	if p.staleBefore.IsZero() {
+		p.staleKind = domain.MovieItem
+		p.staleCursor = 0
+		p.staleBefore = time.Now().Add(-p.RefreshAfter)
+	}
+
+	for {
+		ids, err := p.Usecase.GetStale(ctx, p.staleKind, p.staleBefore, p.staleCursor, p.PageLimit)
+		if err != nil {
+			return err
+		}
+
+		if len(ids) > 0 {
+			for _, id := range ids {
+				p.staleItems = append(p.staleItems, domain.FrontierItem{ID: id, Kind: p.staleKind})
+			}
+			p.staleCursor = ids[len(ids)-1]
+			return nil
+		}
+
+		if p.staleKind == domain.PersonItem {
+			logrus.Infof("Refresh pass for records fetched before %s is complete", p.staleBefore.Format(time.RFC3339))
+			p.staleBefore = time.Time{}
+			return nil
+		}
+
+		p.staleKind = domain.PersonItem
+		p.staleCursor = 0
+	}
+}
+
+func (p *Parser) refreshItem(ctx context.Context, item domain.FrontierItem) error {
+	logrus.Infof("Refresh item (kind = %d, id = %d)", item.Kind, item.ID)
+
+	var changed uint64
+	if item.Kind == domain.PersonItem {
+		person, err := p.Source.GetPerson(ctx, item.ID)
+		if err != nil {
+			logrus.Errorf("Parser error person fetch: %v", err)
+			return err
+		}
+
+		changed, err = p.Usecase.SavePerson(context.Background(), newPerson(person))
+		if err != nil {
+			return err
+		}
+
+		if p.FetchAwards {
+			err = p.parsePersonAwards(ctx, item)
+			if err != nil {
+				return err
+			}
+		}
+	} else {
+		movie, err := p.Source.GetMovie(ctx, item.ID)
+		if err != nil {
+			logrus.Errorf("Parser error movie fetch: %v", err)
+			return err
+		}
+
+		result := newMovie(movie)
+		changed, err = p.Usecase.Update(context.Background(), &result)
+		if err != nil {
+			return err
+		}
+
+		err = p.saveRelations(movie)
+		if err != nil {
+			return err
+		}
+
+		err = p.savePremieres(movie)
+		if err != nil {
+			return err
+		}
+
+		if p.FetchSeasons && movie.IsSeries {
+			err = p.parseSeasons(ctx, item)
+			if err != nil {
+				return err
+			}
+		}
+
+		if p.FetchAwards {
+			err = p.parseMovieAwards(ctx, item)
+			if err != nil {
+				return err
+			}
+		}
+
+		if p.FetchReviews {
+			err = p.parseReviews(ctx, item)
+			if err != nil {
+				return err
+			}
+		}
+
+		if p.FetchStudios {
+			err = p.parseStudios(ctx, item)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	p.countChanged(item, changed)
+
+	return nil
+}
+
+func (p *Parser) countChanged(item domain.FrontierItem, changed uint64) {
+	logrus.Infof("Item (kind = %d, id = %d) refreshed, %d fields changed", item.Kind, item.ID, changed)
+	if changed == 0 {
+		return
+	}
+
+	atomic.AddUint64(&p.changedFields, changed)
+
+	_, err := p.Frontier.IncState(context.Background(), domain.ChangedFieldsKey, changed)
+	if err != nil {
+		logrus.Errorf("Parser error count changed fields: %v", err)
+	}
+}
@@ -0,0 +1,43 @@
+package parser
+
+import (
+	"Kinopoisk-Parser/internal/domain"
+	"context"
+)
+
+func (p *Parser) enqueueRelated(movie domain.MovieDTO, parent domain.FrontierItem) {
+	for _, related := range movie.SequelsAndPrequels {
+		p.enqueue(domain.MovieItem, related.Id, p.moviePriority(related.Rating.Imdb, related.Votes.Kp), parent)
+	}
+
+	for _, related := range movie.SimilarMovies {
+		p.enqueue(domain.MovieItem, related.Id, p.moviePriority(related.Rating.Imdb, related.Votes.Kp), parent)
+	}
+}
+
+func (p *Parser) saveRelations(movie domain.MovieDTO) error {
+	relations := make([]domain.MovieRelation, 0, len(movie.SequelsAndPrequels)+len(movie.SimilarMovies))
+	relations = appendRelations(relations, uint64(movie.Id), domain.SequelRelation, movie.SequelsAndPrequels)
+	relations = appendRelations(relations, uint64(movie.Id), domain.SimilarRelation, movie.SimilarMovies)
+
+	return p.Relations.Save(context.Background(), uint64(movie.Id), relations)
+}
+
+func appendRelations(relations []domain.MovieRelation, movieID uint64, relationType string,
+	linked []domain.LinkedMovieDTO) []domain.MovieRelation {
+	for _, related := range linked {
+		if related.Id == 0 || uint64(related.Id) == movieID {
+			continue
+		}
+
+		relations = append(relations, domain.MovieRelation{
+			MovieID:   movieID,
+			RelatedID: uint64(related.Id),
+			Type:      relationType,
+			Name:      related.Name,
+			Year:      uint64(related.Year),
+		})
+	}
+
+	return relations
+}
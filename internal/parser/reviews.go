@@ -0,0 +1,42 @@
+package parser
+
+import (
+	"Kinopoisk-Parser/internal/domain"
+	"context"
+	"github.com/sirupsen/logrus"
+)
+
+func (p *Parser) parseReviews(ctx context.Context, item domain.FrontierItem) error {
+	logrus.Infof("Parse reviews of movie with index = %d", item.ID)
+
+	docs, err := fetchPages(ctx, item.ID, p.PageLimit, p.Source.GetReviews)
+	if err != nil {
+		logrus.Errorf("Parser error reviews fetch: %v", err)
+		return err
+	}
+
+	reviews := make([]domain.Review, 0, len(docs))
+	for _, review := range docs {
+		reviews = append(reviews, newReview(item.ID, review))
+	}
+
+	return p.Reviews.Save(context.Background(), item.ID, reviews)
+}
+
+func newReview(movieID uint64, review domain.ReviewDTO) domain.Review {
+	reviewType, ok := domain.ReviewTypes[review.Type]
+	if !ok {
+		logrus.Infof("Unknown type %q of review with id = %d, store as neutral", review.Type, review.Id)
+		reviewType = domain.NeutralReview
+	}
+
+	return domain.Review{
+		ID:      uint64(review.Id),
+		MovieID: movieID,
+		Title:   review.Title,
+		Review:  review.Review,
+		Type:    reviewType,
+		Author:  review.Author,
+		Date:    review.Date,
+	}
+}
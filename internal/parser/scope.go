@@ -0,0 +1,90 @@
+package parser
+
+import (
+	"Kinopoisk-Parser/internal/domain"
+	"context"
+	"fmt"
+	"github.com/sirupsen/logrus"
+	"hash/fnv"
+)
+
+// checkStoreScope forgets the movies rejected by the store scope once the
+// scope changes, so they are fetched again under the new one.
+func (p *Parser) checkStoreScope(ctx context.Context) {
+	scope := scopeHash(p.StoreScope)
+
+	stored, err := p.Frontier.GetState(ctx, domain.StoreScopeKey)
+	if err != nil && err != domain.StateNotFound {
+		logrus.Errorf("Parser error load %s: %v", domain.StoreScopeKey, err)
+		return
+	}
+	if err == nil && stored == scope {
+		return
+	}
+
+	if err == nil {
+		forgotten, err := p.Visited.ForgetRejected(ctx)
+		if err != nil {
+			logrus.Errorf("Parser error forget rejected: %v", err)
+			return
+		}
+		logrus.Infof("Store scope changed, %d rejected movies are fetched again", forgotten)
+	}
+
+	err = p.Frontier.SetState(ctx, domain.StoreScopeKey, scope)
+	if err != nil {
+		logrus.Errorf("Parser error save %s: %v", domain.StoreScopeKey, err)
+	}
+}
+
+// scopeHash fits in 63 bits, as the state is stored as a signed bigint.
+func scopeHash(filter domain.MovieFilter) uint64 {
+	hash := fnv.New64a()
+	_, _ = fmt.Fprintf(hash, "%+v", filter)
+
+	return hash.Sum64() >> 1
+}
+
+func inScope(filter domain.MovieFilter, movie domain.MovieDTO) bool {
+	year := uint64(movie.Year)
+	if filter.YearFrom > 0 && year < filter.YearFrom {
+		return false
+	}
+	if filter.YearTo > 0 && year > filter.YearTo {
+		return false
+	}
+
+	if filter.MinRating > 0 && movie.Rating.Imdb < filter.MinRating {
+		return false
+	}
+
+	// Kinopoisk reports no length for most series, so 0 is unknown, not short.
+	if filter.MinLength > 0 && movie.MovieLength > 0 && uint64(movie.MovieLength) < filter.MinLength {
+		return false
+	}
+
+	if len(filter.Types) > 0 && !contains(filter.Types, movie.Type) {
+		return false
+	}
+
+	if len(filter.Countries) > 0 {
+		for _, country := range movie.Countries {
+			if contains(filter.Countries, country.Name) {
+				return true
+			}
+		}
+		return false
+	}
+
+	return true
+}
+
+func contains(values []string, value string) bool {
+	for _, el := range values {
+		if el == value {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,60 @@
+package parser
+
+import (
+	"Kinopoisk-Parser/internal/domain"
+	"context"
+	"github.com/sirupsen/logrus"
+)
+
+func (p *Parser) parseSeasons(ctx context.Context, item domain.FrontierItem) error {
+	logrus.Infof("Parse seasons of series with index = %d", item.ID)
+
+	docs, err := fetchPages(ctx, item.ID, p.PageLimit, p.Source.GetSeasons)
+	if err != nil {
+		logrus.Errorf("Parser error seasons fetch: %v", err)
+		return err
+	}
+
+	seasons := make([]domain.Season, 0, len(docs))
+	for _, season := range docs {
+		seasons = append(seasons, newSeason(item.ID, season))
+	}
+
+	return p.Seasons.Save(context.Background(), seasons)
+}
+
+func newSeason(movieID uint64, season domain.SeasonDTO) domain.Season {
+	result := domain.Season{
+		MovieID:       movieID,
+		Number:        uint64(season.Number),
+		Name:          season.Name,
+		EnName:        season.EnName,
+		EpisodesCount: uint64(season.EpisodesCount),
+		AirDate:       season.AirDate,
+		Description:   season.Description,
+		Episodes:      make([]domain.Episode, 0, len(season.Episodes)),
+	}
+
+	for _, episode := range season.Episodes {
+		airDate := episode.AirDate
+		if airDate == nil {
+			airDate = episode.Date
+		}
+
+		result.Episodes = append(result.Episodes, domain.Episode{
+			MovieID:      movieID,
+			SeasonNumber: result.Number,
+			Number:       uint64(episode.Number),
+			Name:         episode.Name,
+			EnName:       episode.EnName,
+			AirDate:      airDate,
+			Description:  episode.Description,
+		})
+	}
+
+	if result.EpisodesCount == 0 {
+		result.EpisodesCount = uint64(len(result.Episodes))
+	}
+
+	return result
+}
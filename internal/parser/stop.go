@@ -0,0 +1,59 @@
+package parser
+
+import (
+	"context"
+	"errors"
+	"github.com/sirupsen/logrus"
+	"sync/atomic"
+	"time"
+)
+
+type StopReason string
+
+const (
+	StopMaxMovies     StopReason = "max_movies"
+	StopDeadline      StopReason = "deadline"
+	StopRequestBudget StopReason = "daily_request_budget"
+	StopTokens        StopReason = "tokens_exhausted"
+	StopCancelled     StopReason = "cancelled"
+)
+
+func (p *Parser) stop(reason StopReason) {
+	p.stopOnce.Do(func() {
+		p.stopReason = reason
+		p.cancel()
+	})
+}
+
+func (p *Parser) reason(ctx context.Context) StopReason {
+	p.stopOnce.Do(func() {
+		p.stopReason = StopCancelled
+		if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+			p.stopReason = StopDeadline
+		}
+	})
+
+	return p.stopReason
+}
+
+func (p *Parser) loadStoredMovies(ctx context.Context) uint64 {
+	for {
+		count, err := p.Usecase.Count(ctx)
+		if err == nil {
+			return count
+		}
+
+		logrus.Errorf("Parser error count movies: %v", err)
+		if ctx.Err() != nil {
+			return 0
+		}
+		time.Sleep(time.Second * time.Duration(p.TimeForSleep))
+	}
+}
+
+func (p *Parser) countStoredMovie() {
+	stored := atomic.AddUint64(&p.storedMovies, 1)
+	if p.MaxMovies > 0 && stored >= p.MaxMovies {
+		p.stop(StopMaxMovies)
+	}
+}
@@ -0,0 +1,28 @@
+package parser
+
+import "Kinopoisk-Parser/internal/domain"
+
+// moviePriority ranks a movie by its IMDb rating with the rating strategy and
+// by its kinopoisk vote count with the votes strategy.
+func (p *Parser) moviePriority(rating float64, votes int) float64 {
+	switch p.Strategy {
+	case domain.RatingStrategy:
+		return rating
+	case domain.VotesStrategy:
+		return float64(votes)
+	default:
+		return 0
+	}
+}
+
+// castPriority is added up every time a person is found in a cast. The cast
+// does not tell the size of a person's filmography, so the priority grows with
+// the number of crawled movies the person appears in instead. With the votes
+// strategy each appearance is weighted by the movie's vote count.
+func (p *Parser) castPriority(movie domain.MovieDTO) float64 {
+	if p.Strategy == domain.VotesStrategy {
+		return float64(movie.Votes.Kp)
+	}
+
+	return 1
+}
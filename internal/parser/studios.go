@@ -0,0 +1,42 @@
+package parser
+
+import (
+	"Kinopoisk-Parser/internal/domain"
+	"context"
+	"github.com/sirupsen/logrus"
+	"strings"
+)
+
+func (p *Parser) parseStudios(ctx context.Context, item domain.FrontierItem) error {
+	logrus.Infof("Parse studios of movie with index = %d", item.ID)
+
+	docs, err := fetchPages(ctx, item.ID, p.PageLimit, p.Source.GetStudios)
+	if err != nil {
+		logrus.Errorf("Parser error studios fetch: %v", err)
+		return err
+	}
+
+	studios := make([]domain.Studio, 0, len(docs))
+	for _, studio := range docs {
+		studios = append(studios, newStudio(studio))
+	}
+
+	return p.Studios.Save(context.Background(), item.ID, studios)
+}
+
+// newStudio keeps an unknown studio type as it came from kinopoisk, lowercased,
+// so a new type is not lost until it is added to domain.StudioTypes.
+func newStudio(studio domain.StudioDTO) domain.Studio {
+	studioType, ok := domain.StudioTypes[studio.Type]
+	if !ok {
+		logrus.Infof("Unknown type %q of studio with id = %d", studio.Type, studio.Id)
+		studioType = strings.ToLower(studio.Type)
+	}
+
+	return domain.Studio{
+		ID:      uint64(studio.Id),
+		Title:   studio.Title,
+		Type:    studioType,
+		SubType: studio.SubType,
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"context"
 	"github.com/neo4j/neo4j-go-driver/v5/neo4j"
 	"github.com/sirupsen/logrus"
+	"time"
 )
 
 type Neo4jPersonRepo struct {
@@ -19,28 +20,23 @@ func (n Neo4jPersonRepo) GetByID(ctx context.Context, id uint64) (domain.Person,
 	result, err := neo4j.ExecuteQuery(ctx, n.Driver,
 		"MATCH (p:Person {ID: $id}) return p",
 		map[string]any{
-			"ID": id,
+			"id": id,
 		}, neo4j.EagerResultTransformer)
 	if err != nil {
 		logrus.Error(err)
 		return domain.Person{}, err
 	}
 
+	if len(result.Records) == 0 {
+		return domain.Person{}, domain.PersonNotFound
+	}
+
 	itemNode, _, err := neo4j.GetRecordValue[neo4j.Node](result.Records[0], "p")
 	if err != nil {
 		return domain.Person{}, domain.PersonNotFound
 	}
 
-	person := domain.Person{ID: id}
-	person.FullName, _ = neo4j.GetProperty[string](itemNode, "Full_name")
-
-	age, _ := neo4j.GetProperty[int64](itemNode, "Age")
-	person.Age = uint64(age)
-
-	height, _ := neo4j.GetProperty[int64](itemNode, "Height")
-	person.Age = uint64(height)
-
-	return person, nil
+	return personFromNode(itemNode), nil
 }
 
 func (n Neo4jPersonRepo) GetByFullName(ctx context.Context, title string) (domain.Person, error) {
@@ -54,25 +50,16 @@ func (n Neo4jPersonRepo) GetByFullName(ctx context.Context, title string) (domai
 		return domain.Person{}, err
 	}
 
+	if len(result.Records) == 0 {
+		return domain.Person{}, domain.PersonNotFound
+	}
+
 	itemNode, _, err := neo4j.GetRecordValue[neo4j.Node](result.Records[0], "p")
 	if err != nil {
 		return domain.Person{}, domain.PersonNotFound
 	}
 
-	person := domain.Person{FullName: title}
-
-	id, _ := neo4j.GetProperty[int64](itemNode, "ID")
-	person.ID = uint64(id)
-
-	person.FullName, _ = neo4j.GetProperty[string](itemNode, "Full_name")
-
-	age, _ := neo4j.GetProperty[int64](itemNode, "Age")
-	person.Age = uint64(age)
-
-	height, _ := neo4j.GetProperty[int64](itemNode, "Height")
-	person.Height = uint64(height)
-
-	return person, nil
+	return personFromNode(itemNode), nil
 }
 
 func (n Neo4jPersonRepo) GetPersons(ctx context.Context, limit, offset uint64) ([]domain.Person, error) {
@@ -93,35 +80,167 @@ func (n Neo4jPersonRepo) GetPersons(ctx context.Context, limit, offset uint64) (
 			return resultPersons, err
 		}
 
-		person := domain.Person{}
-		id, _ := neo4j.GetProperty[int64](itemNode, "ID")
-		person.ID = uint64(id)
+		resultPersons = append(resultPersons, personFromNode(itemNode))
+	}
+
+	return resultPersons, nil
+}
+
+// GetByMovie returns every credit of the movie in one query, relationships of
+// types outside the role catalogue are skipped.
+func (n Neo4jPersonRepo) GetByMovie(ctx context.Context, movieID uint64) ([]domain.RoleCredit, error) {
+	result, err := neo4j.ExecuteQuery(ctx, n.Driver,
+		"MATCH (p:Person)-[r]->(m:Movie {ID: $id}) return p, r, type(r) AS relation ORDER BY r.Position",
+		map[string]any{
+			"id": movieID,
+		}, neo4j.EagerResultTransformer)
+	if err != nil {
+		logrus.Error(err)
+		return nil, err
+	}
+
+	credits := make([]domain.RoleCredit, 0)
+	for _, record := range result.Records {
+		relationType, _, err := neo4j.GetRecordValue[string](record, "relation")
+		if err != nil {
+			return nil, err
+		}
+
+		role, err := domain.RoleByRelationType(relationType)
+		if err != nil {
+			continue
+		}
+
+		personNode, _, err := neo4j.GetRecordValue[neo4j.Node](record, "p")
+		if err != nil {
+			return nil, err
+		}
+
+		relation, _, err := neo4j.GetRecordValue[neo4j.Relationship](record, "r")
+		if err != nil {
+			return nil, err
+		}
+
+		credit := domain.RoleCredit{Role: role.ID}
+		credit.Person = personFromNode(personNode)
+		credit.Character, _ = neo4j.GetProperty[string](relation, "Character")
+
+		position, _ := neo4j.GetProperty[int64](relation, "Position")
+		credit.Position = uint64(position)
 
-		age, _ := neo4j.GetProperty[int64](itemNode, "Age")
-		person.Age = uint64(age)
+		credits = append(credits, credit)
+	}
 
-		height, _ := neo4j.GetProperty[int64](itemNode, "Height")
-		person.Height = uint64(height)
+	return credits, nil
+}
+
+func personFromNode(itemNode neo4j.Node) domain.Person {
+	person := domain.Person{}
+
+	id, _ := neo4j.GetProperty[int64](itemNode, "ID")
+	person.ID = uint64(id)
+
+	person.FullName, _ = neo4j.GetProperty[string](itemNode, "Full_name")
+	person.EnName, _ = neo4j.GetProperty[string](itemNode, "EnName")
+	person.Photo, _ = neo4j.GetProperty[string](itemNode, "Photo")
+	person.Sex, _ = neo4j.GetProperty[string](itemNode, "Sex")
+	person.Birthplace, _ = neo4j.GetProperty[string](itemNode, "Birthplace")
+
+	age, _ := neo4j.GetProperty[int64](itemNode, "Age")
+	person.Age = uint64(age)
 
-		resultPersons = append(resultPersons, person)
+	height, _ := neo4j.GetProperty[int64](itemNode, "Height")
+	person.Height = uint64(height)
+
+	birthday, err := neo4j.GetProperty[time.Time](itemNode, "Birthday")
+	if err == nil {
+		person.Birthday = &birthday
 	}
 
-	return resultPersons, nil
+	death, err := neo4j.GetProperty[time.Time](itemNode, "Death")
+	if err == nil {
+		person.Death = &death
+	}
+
+	person.FetchedAt, _ = neo4j.GetProperty[time.Time](itemNode, "FetchedAt")
+
+	return person
 }
 
 func (n Neo4jPersonRepo) Add(ctx context.Context, m *domain.Person) error {
 	_, err := neo4j.ExecuteQuery(ctx, n.Driver,
-		"CREATE (p:Person {ID: $id, Full_name: $full_name, Age: $age, Height: $height}) RETURN m",
+		"CREATE (p:Person $props) RETURN p",
+		map[string]any{
+			"props": personProps(m),
+		}, neo4j.EagerResultTransformer)
+
+	return err
+}
+
+func (n Neo4jPersonRepo) Update(ctx context.Context, m *domain.Person) error {
+	_, err := neo4j.ExecuteQuery(ctx, n.Driver,
+		"MATCH (p:Person {ID: $id}) SET p += $props",
 		map[string]any{
-			"ID":        m.ID,
-			"full_name": m.FullName,
-			"age":       m.Age,
-			"height":    m.Height,
+			"id":    m.ID,
+			"props": personProps(m),
 		}, neo4j.EagerResultTransformer)
 
 	return err
 }
 
+func personProps(m *domain.Person) map[string]any {
+	props := map[string]any{
+		"ID":         m.ID,
+		"Full_name":  m.FullName,
+		"EnName":     m.EnName,
+		"Photo":      m.Photo,
+		"Sex":        m.Sex,
+		"Age":        m.Age,
+		"Height":     m.Height,
+		"Birthday":   nil,
+		"Death":      nil,
+		"Birthplace": m.Birthplace,
+		"FetchedAt":  m.FetchedAt,
+	}
+
+	if m.Birthday != nil {
+		props["Birthday"] = *m.Birthday
+	}
+
+	if m.Death != nil {
+		props["Death"] = *m.Death
+	}
+
+	return props
+}
+
+func (n Neo4jPersonRepo) GetStale(ctx context.Context, before time.Time, afterID, limit uint64) ([]uint64, error) {
+	result, err := neo4j.ExecuteQuery(ctx, n.Driver,
+		"MATCH (p:Person) WHERE p.ID > $afterID AND (p.FetchedAt IS NULL OR p.FetchedAt < $before) "+
+			"RETURN p.ID AS id ORDER BY id LIMIT $limit",
+		map[string]any{
+			"before":  before,
+			"afterID": afterID,
+			"limit":   limit,
+		}, neo4j.EagerResultTransformer)
+	if err != nil {
+		logrus.Error(err)
+		return nil, err
+	}
+
+	ids := make([]uint64, 0, len(result.Records))
+	for _, record := range result.Records {
+		id, _, err := neo4j.GetRecordValue[int64](record, "id")
+		if err != nil {
+			return ids, err
+		}
+
+		ids = append(ids, uint64(id))
+	}
+
+	return ids, nil
+}
+
 func (n Neo4jPersonRepo) Delete(ctx context.Context, id uint64) error {
 	_, err := neo4j.ExecuteQuery(ctx, n.Driver,
 		"DELETE (p:Person {ID: $id})",
@@ -5,8 +5,11 @@ import (
 	"context"
 	"database/sql"
 	"github.com/sirupsen/logrus"
+	"time"
 )
 
+const personColumns = `id, full_name, en_name, photo, sex, age, height, birthday, death, birthplace, fetched_at`
+
 type pgPersonRepo struct {
 	Conn *sql.DB
 }
@@ -16,9 +19,23 @@ func New(conn *sql.DB) domain.PersonRepository {
 }
 
 func (p pgPersonRepo) GetByID(ctx context.Context, id uint64) (domain.Person, error) {
-	query := `SELECT id, full_name, age, height FROM person WHERE id = $1;`
+	query := `SELECT ` + personColumns + ` FROM person WHERE id = $1;`
+
+	return p.getOne(ctx, query, id)
+}
 
-	rows, err := p.Conn.QueryContext(ctx, query, id)
+func (p pgPersonRepo) GetByFullName(ctx context.Context, title string) (domain.Person, error) {
+	query := `SELECT ` + personColumns + ` FROM person WHERE full_name = $1;`
+
+	return p.getOne(ctx, query, title)
+}
+
+func (p pgPersonRepo) getOne(ctx context.Context, query string, arg any) (domain.Person, error) {
+	rows, err := p.Conn.QueryContext(ctx, query, arg)
+	if err != nil {
+		logrus.Errorf("Repo error: %v", err)
+		return domain.Person{}, err
+	}
 
 	defer func() {
 		err := rows.Close()
@@ -29,11 +46,7 @@ func (p pgPersonRepo) GetByID(ctx context.Context, id uint64) (domain.Person, er
 
 	person := domain.Person{}
 	if rows.Next() {
-		err = rows.Scan(
-			&person.ID,
-			&person.FullName,
-			&person.Age,
-			&person.Height)
+		person, err = scanPerson(rows)
 	} else {
 		err = domain.PersonNotFound
 	}
@@ -44,13 +57,17 @@ func (p pgPersonRepo) GetByID(ctx context.Context, id uint64) (domain.Person, er
 	}
 
 	return person, err
-
 }
 
-func (p pgPersonRepo) GetByFullName(ctx context.Context, title string) (domain.Person, error) {
-	query := `SELECT id, full_name, age, height FROM person WHERE full_name = $1;`
+func (p pgPersonRepo) GetPersons(ctx context.Context, limit, offset uint64) ([]domain.Person, error) {
+	query := `SELECT ` + personColumns + ` FROM person LIMIT $1 OFFSET $2;`
+
+	rows, err := p.Conn.QueryContext(ctx, query, limit, offset)
 
-	rows, err := p.Conn.QueryContext(ctx, query, title)
+	if err != nil {
+		logrus.Errorf("Repo error: %v", err)
+		return nil, err
+	}
 
 	defer func() {
 		err := rows.Close()
@@ -59,31 +76,28 @@ func (p pgPersonRepo) GetByFullName(ctx context.Context, title string) (domain.P
 		}
 	}()
 
-	person := domain.Person{}
-	if rows.Next() {
-		err = rows.Scan(
-			&person.ID,
-			&person.FullName,
-			&person.Age,
-			&person.Height)
-	} else {
-		err = domain.PersonNotFound
-	}
+	result := make([]domain.Person, 0)
+	for rows.Next() {
+		tmpPerson, err := scanPerson(rows)
+		if err != nil {
+			logrus.Errorf("Repo error: %v", err)
+			return nil, err
+		}
 
-	if err != nil && err != domain.PersonNotFound {
-		logrus.Errorf("Repo error: %v", err)
-		return domain.Person{}, err
+		result = append(result, tmpPerson)
 	}
 
-	return person, err
-
+	return result, err
 }
 
-func (p pgPersonRepo) GetPersons(ctx context.Context, limit, offset uint64) ([]domain.Person, error) {
-	query := `SELECT id, full_name, age, height FROM person LIMIT $1 OFFSET $2;`
-
-	rows, err := p.Conn.QueryContext(ctx, query, limit, offset)
+// GetByMovie returns every credit of the movie in one query, ordered by role
+// and billing position.
+func (p pgPersonRepo) GetByMovie(ctx context.Context, movieID uint64) ([]domain.RoleCredit, error) {
+	query := `SELECT ` + personColumns + `, movie_role, character_name, position FROM person
+			 JOIN (SELECT person_id, movie_role, character_name, position FROM professions WHERE movie_id = $1) pr
+			 ON pr.person_id = person.id ORDER BY movie_role, position, person_id;`
 
+	rows, err := p.Conn.QueryContext(ctx, query, movieID)
 	if err != nil {
 		logrus.Errorf("Repo error: %v", err)
 		return nil, err
@@ -96,34 +110,106 @@ func (p pgPersonRepo) GetPersons(ctx context.Context, limit, offset uint64) ([]d
 		}
 	}()
 
-	result := make([]domain.Person, 0)
+	result := make([]domain.RoleCredit, 0)
 	for rows.Next() {
-		tmpPerson := domain.Person{}
+		credit := domain.RoleCredit{}
 		err = rows.Scan(
-			&tmpPerson.ID,
-			&tmpPerson.FullName,
-			&tmpPerson.Age,
-			&tmpPerson.Height)
-
+			&credit.ID,
+			&credit.FullName,
+			&credit.EnName,
+			&credit.Photo,
+			&credit.Sex,
+			&credit.Age,
+			&credit.Height,
+			&credit.Birthday,
+			&credit.Death,
+			&credit.Birthplace,
+			&credit.FetchedAt,
+			&credit.Role,
+			&credit.Character,
+			&credit.Position)
 		if err != nil {
 			logrus.Errorf("Repo error: %v", err)
 			return nil, err
 		}
 
-		result = append(result, tmpPerson)
+		result = append(result, credit)
 	}
 
-	return result, err
+	return result, rows.Err()
+}
+
+func scanPerson(rows *sql.Rows) (domain.Person, error) {
+	person := domain.Person{}
+	err := rows.Scan(
+		&person.ID,
+		&person.FullName,
+		&person.EnName,
+		&person.Photo,
+		&person.Sex,
+		&person.Age,
+		&person.Height,
+		&person.Birthday,
+		&person.Death,
+		&person.Birthplace,
+		&person.FetchedAt)
+
+	return person, err
 }
 
 func (p pgPersonRepo) Add(ctx context.Context, m *domain.Person) error {
-	query := `INSERT into person(id, full_name, age, height) VALUES ($1, $2, $3, $4);`
+	query := `INSERT into person(` + personColumns + `) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11);`
 
-	_, err := p.Conn.ExecContext(ctx, query, m.ID, m.FullName, m.Age, m.Height)
+	_, err := p.Conn.ExecContext(ctx, query, personArgs(m)...)
 
 	return err
 }
 
+func (p pgPersonRepo) Update(ctx context.Context, m *domain.Person) error {
+	query := `UPDATE person SET full_name = $2, en_name = $3, photo = $4, sex = $5, age = $6, height = $7,
+			 birthday = $8, death = $9, birthplace = $10, fetched_at = $11 WHERE id = $1;`
+
+	_, err := p.Conn.ExecContext(ctx, query, personArgs(m)...)
+
+	return err
+}
+
+func personArgs(m *domain.Person) []any {
+	return []any{m.ID, m.FullName, m.EnName, m.Photo, m.Sex, m.Age, m.Height, m.Birthday, m.Death, m.Birthplace,
+		m.FetchedAt}
+}
+
+func (p pgPersonRepo) GetStale(ctx context.Context, before time.Time, afterID, limit uint64) ([]uint64, error) {
+	query := `SELECT id FROM person WHERE fetched_at < $1 AND id > $2 ORDER BY id LIMIT $3;`
+
+	rows, err := p.Conn.QueryContext(ctx, query, before, afterID, limit)
+	if err != nil {
+		logrus.Errorf("Repo error: %v", err)
+		return nil, err
+	}
+
+	defer func() {
+		err := rows.Close()
+		if err != nil {
+			logrus.Errorf("Repo closing error: %v", err)
+		}
+	}()
+
+	result := make([]uint64, 0)
+	for rows.Next() {
+		var id uint64
+		err = rows.Scan(&id)
+		if err != nil {
+			logrus.Errorf("Repo error: %v", err)
+			return nil, err
+		}
+
+		result = append(result, id)
+	}
+
+	return result, err
+}
+
 func (p pgPersonRepo) Delete(ctx context.Context, id uint64) error {
 	query := `DELETE FROM person WHERE id = $1;`
 
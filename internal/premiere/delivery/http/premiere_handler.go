@@ -0,0 +1,102 @@
+package http
+
+import (
+	"Kinopoisk-Parser/internal/delivery/query"
+	"Kinopoisk-Parser/internal/domain"
+	"context"
+	"encoding/json"
+	"github.com/gorilla/mux"
+	"github.com/sirupsen/logrus"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+const dateLayout = "2006-01-02"
+
+type PremiereHandler struct {
+	PUsecase domain.PremiereUsecase
+}
+
+func NewPremiereHandler(usecase domain.PremiereUsecase) PremiereHandler {
+	return PremiereHandler{PUsecase: usecase}
+}
+
+func (h *PremiereHandler) GetMoviePremieres(w http.ResponseWriter, r *http.Request) {
+	movieID, err := strconv.ParseUint(mux.Vars(r)["id"], 10, 64)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		logrus.Error("Bad request: movie id")
+		return
+	}
+
+	premieres, err := h.PUsecase.GetByMovie(context.Background(), movieID)
+	writePremieres(w, premieres, err)
+}
+
+// Find takes the date range as from and to in the YYYY-MM-DD form, both are
+// inclusive and optional.
+func (h *PremiereHandler) Find(w http.ResponseWriter, r *http.Request) {
+	from, err := queryDate(r, "from")
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		logrus.Errorf("Bad request: from: %v", err)
+		return
+	}
+
+	to, err := queryDate(r, "to")
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		logrus.Errorf("Bad request: to: %v", err)
+		return
+	}
+
+	filter := domain.PremiereFilter{
+		Country: r.URL.Query().Get("country"),
+		From:    from,
+		To:      to,
+	}
+
+	limit, offset := query.Page(r)
+
+	premieres, err := h.PUsecase.Find(context.Background(), filter, limit, offset)
+	writePremieres(w, premieres, err)
+}
+
+func writePremieres(w http.ResponseWriter, premieres []domain.Premiere, err error) {
+	switch err {
+	case domain.UnknownPremiere, domain.InvalidDateRange:
+		w.WriteHeader(http.StatusBadRequest)
+		logrus.Errorf("Bad request: %v", err)
+		return
+	case nil:
+
+	default:
+		w.WriteHeader(http.StatusInternalServerError)
+		logrus.Errorf("Error while get premieres: %v", err)
+		return
+	}
+
+	premieresRaw, err := json.Marshal(premieres)
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		logrus.Errorf("Error while marshal: %v", err)
+		return
+	}
+
+	w.Write(premieresRaw)
+}
+
+func queryDate(r *http.Request, name string) (*time.Time, error) {
+	value := r.URL.Query().Get(name)
+	if value == "" {
+		return nil, nil
+	}
+
+	date, err := time.Parse(dateLayout, value)
+	if err != nil {
+		return nil, err
+	}
+
+	return &date, nil
+}
@@ -0,0 +1,110 @@
+package http
+
+import (
+	"Kinopoisk-Parser/internal/domain"
+	"context"
+	"encoding/json"
+	"github.com/gorilla/mux"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+type fakePremieres struct {
+	domain.PremiereUsecase
+	movieID       uint64
+	filter        domain.PremiereFilter
+	limit, offset uint64
+	err           error
+}
+
+func (f *fakePremieres) GetByMovie(ctx context.Context, movieID uint64) ([]domain.Premiere, error) {
+	f.movieID = movieID
+
+	date := time.Date(1999, 3, 31, 0, 0, 0, 0, time.UTC)
+
+	return []domain.Premiere{{MovieID: movieID, Country: domain.WorldPremiere, Date: date}}, f.err
+}
+
+func (f *fakePremieres) Find(ctx context.Context, filter domain.PremiereFilter, limit, offset uint64) ([]domain.Premiere, error) {
+	f.filter, f.limit, f.offset = filter, limit, offset
+
+	return []domain.Premiere{}, f.err
+}
+
+func serve(usecase domain.PremiereUsecase, target string) *httptest.ResponseRecorder {
+	handler := NewPremiereHandler(usecase)
+
+	r := mux.NewRouter()
+	r.HandleFunc("/movies/{id:[0-9]+}/premieres", handler.GetMoviePremieres).Methods("GET")
+	r.HandleFunc("/premieres", handler.Find).Methods("GET")
+
+	recorder := httptest.NewRecorder()
+	r.ServeHTTP(recorder, httptest.NewRequest("GET", target, nil))
+
+	return recorder
+}
+
+func TestGetMoviePremieres(t *testing.T) {
+	usecase := &fakePremieres{}
+
+	recorder := serve(usecase, "/movies/301/premieres")
+	if recorder.Code != http.StatusOK {
+		t.Fatalf("status = %d", recorder.Code)
+	}
+
+	var premieres []domain.Premiere
+	if err := json.Unmarshal(recorder.Body.Bytes(), &premieres); err != nil {
+		t.Fatalf("unmarshal: %v", err)
+	}
+	if usecase.movieID != 301 || len(premieres) != 1 || premieres[0].Date.Year() != 1999 {
+		t.Errorf("movie id = %d, premieres = %+v", usecase.movieID, premieres)
+	}
+}
+
+func TestFindPremieres(t *testing.T) {
+	usecase := &fakePremieres{}
+
+	recorder := serve(usecase, "/premieres?country=russia&from=1999-01-01&to=1999-12-31&limit=50&offset=100")
+	if recorder.Code != http.StatusOK {
+		t.Fatalf("status = %d", recorder.Code)
+	}
+
+	filter := usecase.filter
+	if filter.Country != domain.RussiaPremiere || filter.From == nil || filter.To == nil ||
+		!filter.From.Equal(time.Date(1999, 1, 1, 0, 0, 0, 0, time.UTC)) ||
+		!filter.To.Equal(time.Date(1999, 12, 31, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("filter = %+v", filter)
+	}
+	if usecase.limit != 50 || usecase.offset != 100 {
+		t.Errorf("limit = %d, offset = %d", usecase.limit, usecase.offset)
+	}
+}
+
+func TestFindPremieresOpenRange(t *testing.T) {
+	usecase := &fakePremieres{}
+
+	serve(usecase, "/premieres?from=2000-01-01")
+	if usecase.filter.From == nil || usecase.filter.To != nil {
+		t.Errorf("filter = %+v", usecase.filter)
+	}
+}
+
+func TestFindPremieresBadRequest(t *testing.T) {
+	tests := []struct {
+		target string
+		err    error
+	}{
+		{"/premieres?from=31.12.1999", nil},
+		{"/premieres?to=1999-13-01", nil},
+		{"/premieres?country=mars", domain.UnknownPremiere},
+		{"/premieres?from=2000-01-01&to=1999-01-01", domain.InvalidDateRange},
+	}
+
+	for _, test := range tests {
+		if code := serve(&fakePremieres{err: test.err}, test.target).Code; code != http.StatusBadRequest {
+			t.Errorf("%s: status = %d", test.target, code)
+		}
+	}
+}
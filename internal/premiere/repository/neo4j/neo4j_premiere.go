@@ -0,0 +1,122 @@
+package neo4jPremiereRepo
+
+import (
+	"Kinopoisk-Parser/internal/domain"
+	"context"
+	"github.com/neo4j/neo4j-go-driver/v5/neo4j"
+	"github.com/sirupsen/logrus"
+	"time"
+)
+
+type Neo4jPremiereRepo struct {
+	Driver neo4j.DriverWithContext
+}
+
+func New(driver neo4j.DriverWithContext) domain.PremiereRepository {
+	return &Neo4jPremiereRepo{Driver: driver}
+}
+
+func (n Neo4jPremiereRepo) GetByMovie(ctx context.Context, movieID uint64) ([]domain.Premiere, error) {
+	return n.getPremieres(ctx,
+		"MATCH (p:Premiere)-[:PREMIERE_OF]->(:Movie {ID: $id}) RETURN p ORDER BY p.Date",
+		map[string]any{
+			"id": movieID,
+		})
+}
+
+func (n Neo4jPremiereRepo) Find(ctx context.Context, filter domain.PremiereFilter, limit, offset uint64) ([]domain.Premiere, error) {
+	return n.getPremieres(ctx,
+		"MATCH (p:Premiere) WHERE ($country = '' OR p.Country = $country) "+
+			"AND ($from IS NULL OR p.Date >= $from) AND ($before IS NULL OR p.Date < $before) "+
+			"RETURN p ORDER BY p.Date, p.MovieID SKIP $offset LIMIT $limit",
+		map[string]any{
+			"country": filter.Country,
+			"from":    dateProp(filter.From),
+			"before":  dateProp(filter.Before()),
+			"limit":   limit,
+			"offset":  offset,
+		})
+}
+
+func (n Neo4jPremiereRepo) getPremieres(ctx context.Context, query string, params map[string]any) ([]domain.Premiere, error) {
+	result, err := neo4j.ExecuteQuery(ctx, n.Driver, query, params, neo4j.EagerResultTransformer)
+	if err != nil {
+		logrus.Error(err)
+		return nil, err
+	}
+
+	resultPremieres := make([]domain.Premiere, 0)
+
+	for _, record := range result.Records {
+		itemNode, _, err := neo4j.GetRecordValue[neo4j.Node](record, "p")
+		if err != nil {
+			return nil, err
+		}
+
+		premiere := domain.Premiere{}
+
+		movieID, _ := neo4j.GetProperty[int64](itemNode, "MovieID")
+		premiere.MovieID = uint64(movieID)
+
+		premiere.Country, _ = neo4j.GetProperty[string](itemNode, "Country")
+		premiere.Date, _ = neo4j.GetProperty[time.Time](itemNode, "Date")
+
+		resultPremieres = append(resultPremieres, premiere)
+	}
+
+	return resultPremieres, nil
+}
+
+func (n Neo4jPremiereRepo) Replace(ctx context.Context, movieID uint64, premieres []domain.Premiere) error {
+	props := make([]any, 0, len(premieres))
+	for _, premiere := range premieres {
+		props = append(props, map[string]any{
+			"MovieID": movieID,
+			"Country": premiere.Country,
+			"Date":    premiere.Date,
+		})
+	}
+
+	params := map[string]any{
+		"id":        movieID,
+		"premieres": props,
+	}
+
+	session := n.Driver.NewSession(ctx, neo4j.SessionConfig{})
+	defer func() {
+		err := session.Close(ctx)
+		if err != nil {
+			logrus.Errorf("Repo closing error: %v", err)
+		}
+	}()
+
+	_, err := session.ExecuteWrite(ctx, func(tx neo4j.ManagedTransaction) (any, error) {
+		result, err := tx.Run(ctx, "MATCH (p:Premiere {MovieID: $id}) DETACH DELETE p", params)
+		if err != nil {
+			return nil, err
+		}
+		_, err = result.Consume(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		result, err = tx.Run(ctx,
+			"MATCH (m:Movie {ID: $id}) UNWIND $premieres AS premiere "+
+				"CREATE (p:Premiere) SET p = premiere CREATE (p)-[:PREMIERE_OF]->(m)", params)
+		if err != nil {
+			return nil, err
+		}
+
+		return result.Consume(ctx)
+	})
+
+	return err
+}
+
+func dateProp(date *time.Time) any {
+	if date == nil {
+		return nil
+	}
+
+	return *date
+}
@@ -0,0 +1,94 @@
+package postgresql
+
+import (
+	"Kinopoisk-Parser/internal/domain"
+	"context"
+	"database/sql"
+	"github.com/sirupsen/logrus"
+)
+
+type pgPremiereRepo struct {
+	Conn *sql.DB
+}
+
+func New(conn *sql.DB) domain.PremiereRepository {
+	return &pgPremiereRepo{Conn: conn}
+}
+
+func (p pgPremiereRepo) GetByMovie(ctx context.Context, movieID uint64) ([]domain.Premiere, error) {
+	query := `SELECT movie_id, country, premiere_date FROM premieres WHERE movie_id = $1 ORDER BY premiere_date;`
+
+	return p.getPremieres(ctx, query, movieID)
+}
+
+func (p pgPremiereRepo) Find(ctx context.Context, filter domain.PremiereFilter, limit, offset uint64) ([]domain.Premiere, error) {
+	query := `SELECT movie_id, country, premiere_date FROM premieres WHERE ($1 = '' OR country = $1)
+			 AND ($2::timestamptz IS NULL OR premiere_date >= $2) AND ($3::timestamptz IS NULL OR premiere_date < $3)
+			 ORDER BY premiere_date, movie_id LIMIT $4 OFFSET $5;`
+
+	return p.getPremieres(ctx, query, filter.Country, filter.From, filter.Before(), limit, offset)
+}
+
+func (p pgPremiereRepo) getPremieres(ctx context.Context, query string, args ...any) ([]domain.Premiere, error) {
+	rows, err := p.Conn.QueryContext(ctx, query, args...)
+	if err != nil {
+		logrus.Errorf("Repo error: %v", err)
+		return nil, err
+	}
+
+	defer func() {
+		err := rows.Close()
+		if err != nil {
+			logrus.Errorf("Repo closing error: %v", err)
+		}
+	}()
+
+	result := make([]domain.Premiere, 0)
+	for rows.Next() {
+		tmpPremiere := domain.Premiere{}
+		err = rows.Scan(
+			&tmpPremiere.MovieID,
+			&tmpPremiere.Country,
+			&tmpPremiere.Date)
+
+		if err != nil {
+			logrus.Errorf("Repo error: %v", err)
+			return nil, err
+		}
+
+		result = append(result, tmpPremiere)
+	}
+
+	return result, err
+}
+
+func (p pgPremiereRepo) Replace(ctx context.Context, movieID uint64, premieres []domain.Premiere) error {
+	tx, err := p.Conn.BeginTx(ctx, nil)
+	if err != nil {
+		logrus.Errorf("Repo error: %v", err)
+		return err
+	}
+
+	defer func() {
+		err := tx.Rollback()
+		if err != nil && err != sql.ErrTxDone {
+			logrus.Errorf("Repo rollback error: %v", err)
+		}
+	}()
+
+	_, err = tx.ExecContext(ctx, `DELETE FROM premieres WHERE movie_id = $1;`, movieID)
+	if err != nil {
+		return err
+	}
+
+	query := `INSERT into premieres(movie_id, country, premiere_date) VALUES ($1, $2, $3) ON CONFLICT DO NOTHING;`
+
+	for _, premiere := range premieres {
+		_, err = tx.ExecContext(ctx, query, movieID, premiere.Country, premiere.Date)
+		if err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
@@ -0,0 +1,72 @@
+package usecase
+
+import (
+	"Kinopoisk-Parser/internal/domain"
+	"context"
+	"fmt"
+	"github.com/sirupsen/logrus"
+	"time"
+)
+
+type premiereUsecase struct {
+	premiereRepo   domain.PremiereRepository
+	contextTimeout time.Duration
+}
+
+func NewPremiereUsecase(p domain.PremiereRepository, timeout time.Duration) domain.PremiereUsecase {
+	return &premiereUsecase{
+		premiereRepo:   p,
+		contextTimeout: timeout,
+	}
+}
+
+func (u *premiereUsecase) GetByMovie(ctx context.Context, movieID uint64) ([]domain.Premiere, error) {
+	ctx, cancel := context.WithTimeout(ctx, u.contextTimeout)
+	defer cancel()
+
+	premieres, err := u.premiereRepo.GetByMovie(ctx, movieID)
+	if err != nil {
+		logrus.Errorf("Usecase: %v", err)
+		return nil, fmt.Errorf("usecase: %v", err)
+	}
+
+	return premieres, nil
+}
+
+func (u *premiereUsecase) Find(ctx context.Context, filter domain.PremiereFilter, limit, offset uint64) ([]domain.Premiere, error) {
+	switch filter.Country {
+	case "", domain.WorldPremiere, domain.RussiaPremiere, domain.DigitalPremiere, domain.DVDPremiere:
+	default:
+		return nil, domain.UnknownPremiere
+	}
+
+	if filter.From != nil && filter.To != nil && filter.From.After(*filter.To) {
+		return nil, domain.InvalidDateRange
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, u.contextTimeout)
+	defer cancel()
+
+	premieres, err := u.premiereRepo.Find(ctx, filter, limit, offset)
+	if err != nil {
+		logrus.Errorf("Usecase: %v", err)
+		return nil, fmt.Errorf("usecase: %v", err)
+	}
+
+	return premieres, nil
+}
+
+// Save replaces the stored premieres of the movie, so a date missing from a
+// refreshed payload is removed.
+func (u *premiereUsecase) Save(ctx context.Context, movieID uint64, premieres []domain.Premiere) error {
+	ctx, cancel := context.WithTimeout(ctx, u.contextTimeout)
+	defer cancel()
+
+	err := u.premiereRepo.Replace(ctx, movieID, premieres)
+	if err != nil {
+		logrus.Errorf("Usecase: %v", err)
+		return fmt.Errorf("usecase: %v", err)
+	}
+
+	return nil
+}
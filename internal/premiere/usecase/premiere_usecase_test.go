@@ -0,0 +1,54 @@
+package usecase
+
+import (
+	"Kinopoisk-Parser/internal/domain"
+	"context"
+	"testing"
+	"time"
+)
+
+type fakePremiereRepo struct {
+	domain.PremiereRepository
+	calls int
+}
+
+func (f *fakePremiereRepo) Find(ctx context.Context, filter domain.PremiereFilter, limit, offset uint64) ([]domain.Premiere, error) {
+	f.calls++
+
+	return nil, nil
+}
+
+func TestFindValidatesFilter(t *testing.T) {
+	repo := &fakePremiereRepo{}
+	u := NewPremiereUsecase(repo, time.Second)
+	ctx := context.Background()
+
+	from := time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(1999, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	if _, err := u.Find(ctx, domain.PremiereFilter{Country: "mars"}, 20, 0); err != domain.UnknownPremiere {
+		t.Errorf("unknown country: err = %v", err)
+	}
+	if _, err := u.Find(ctx, domain.PremiereFilter{From: &from, To: &to}, 20, 0); err != domain.InvalidDateRange {
+		t.Errorf("reversed range: err = %v", err)
+	}
+	if repo.calls != 0 {
+		t.Fatalf("repository is queried %d times with an invalid filter", repo.calls)
+	}
+
+	if _, err := u.Find(ctx, domain.PremiereFilter{Country: domain.DVDPremiere, From: &from, To: &from}, 20, 0); err != nil {
+		t.Errorf("one day range: err = %v", err)
+	}
+}
+
+func TestFilterBefore(t *testing.T) {
+	if before := (domain.PremiereFilter{}).Before(); before != nil {
+		t.Errorf("open range: before = %v", *before)
+	}
+
+	to := time.Date(1999, 12, 31, 0, 0, 0, 0, time.UTC)
+	before := domain.PremiereFilter{To: &to}.Before()
+	if before == nil || !before.Equal(time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("before = %v", before)
+	}
+}
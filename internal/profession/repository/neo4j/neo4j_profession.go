@@ -15,52 +15,16 @@ func New(driver neo4j.DriverWithContext) domain.ProfessionRepository {
 	return &Neo4jProfessionRepo{Driver: driver}
 }
 
-func (n Neo4jProfessionRepo) GetDirectorsByMovie(ctx context.Context, movieID uint64) ([]domain.Profession, error) {
-	result, err := neo4j.ExecuteQuery(ctx, n.Driver,
-		"MATCH (p:Person)-[:DIRECTED]->(m:Movie {ID: $id) return p, m",
-		map[string]any{
-			"ID": movieID,
-		}, neo4j.EagerResultTransformer)
-
+func (n Neo4jProfessionRepo) GetByMovie(ctx context.Context, movieID, role uint64) ([]domain.Profession, error) {
+	roleInfo, err := domain.RoleByID(role)
 	if err != nil {
-		logrus.Error(err)
 		return nil, err
 	}
 
-	resultProfessions := make([]domain.Profession, 0)
-
-	for _, record := range result.Records {
-		personNode, _, err := neo4j.GetRecordValue[neo4j.Node](record, "p")
-		if err != nil {
-			return nil, err
-		}
-
-		movieNode, _, err := neo4j.GetRecordValue[neo4j.Node](record, "m")
-		if err != nil {
-			return nil, err
-		}
-
-		profession := domain.Profession{}
-
-		personID, _ := neo4j.GetProperty[int64](personNode, "ID")
-		profession.PersonID = uint64(personID)
-
-		movieID, _ := neo4j.GetProperty[int64](movieNode, "ID")
-		profession.MovieID = uint64(movieID)
-
-		profession.Role = domain.DirectorRole
-
-		resultProfessions = append(resultProfessions, profession)
-	}
-
-	return resultProfessions, nil
-}
-
-func (n Neo4jProfessionRepo) GetProducersByMovie(ctx context.Context, movieID uint64) ([]domain.Profession, error) {
 	result, err := neo4j.ExecuteQuery(ctx, n.Driver,
-		"MATCH (p:Person)-[:PRODUCED]->(m:Movie {ID: $id) return p, m",
+		"MATCH (p:Person)-[r:"+roleInfo.RelationType+"]->(m:Movie {ID: $id}) return p, m, r ORDER BY r.Position",
 		map[string]any{
-			"ID": movieID,
+			"id": movieID,
 		}, neo4j.EagerResultTransformer)
 
 	if err != nil {
@@ -81,43 +45,7 @@ func (n Neo4jProfessionRepo) GetProducersByMovie(ctx context.Context, movieID ui
 			return nil, err
 		}
 
-		profession := domain.Profession{}
-
-		personID, _ := neo4j.GetProperty[int64](personNode, "ID")
-		profession.PersonID = uint64(personID)
-
-		movieID, _ := neo4j.GetProperty[int64](movieNode, "ID")
-		profession.MovieID = uint64(movieID)
-
-		profession.Role = domain.ProducerRole
-
-		resultProfessions = append(resultProfessions, profession)
-	}
-
-	return resultProfessions, nil
-}
-
-func (n Neo4jProfessionRepo) GetWritersByMovie(ctx context.Context, movieID uint64) ([]domain.Profession, error) {
-	result, err := neo4j.ExecuteQuery(ctx, n.Driver,
-		"MATCH (p:Person)-[:WROTE]->(m:Movie {ID: $id) return p, m",
-		map[string]any{
-			"ID": movieID,
-		}, neo4j.EagerResultTransformer)
-
-	if err != nil {
-		logrus.Error(err)
-		return nil, err
-	}
-
-	resultProfessions := make([]domain.Profession, 0)
-
-	for _, record := range result.Records {
-		personNode, _, err := neo4j.GetRecordValue[neo4j.Node](record, "p")
-		if err != nil {
-			return nil, err
-		}
-
-		movieNode, _, err := neo4j.GetRecordValue[neo4j.Node](record, "m")
+		relation, _, err := neo4j.GetRecordValue[neo4j.Relationship](record, "r")
 		if err != nil {
 			return nil, err
 		}
@@ -130,7 +58,12 @@ func (n Neo4jProfessionRepo) GetWritersByMovie(ctx context.Context, movieID uint
 		movieID, _ := neo4j.GetProperty[int64](movieNode, "ID")
 		profession.MovieID = uint64(movieID)
 
-		profession.Role = domain.WriterRole
+		profession.Role = role
+
+		profession.Character, _ = neo4j.GetProperty[string](relation, "Character")
+
+		position, _ := neo4j.GetProperty[int64](relation, "Position")
+		profession.Position = uint64(position)
 
 		resultProfessions = append(resultProfessions, profession)
 	}
@@ -138,72 +71,46 @@ func (n Neo4jProfessionRepo) GetWritersByMovie(ctx context.Context, movieID uint
 	return resultProfessions, nil
 }
 
-func (n Neo4jProfessionRepo) GetActorsByMovie(ctx context.Context, movieID uint64) ([]domain.Profession, error) {
-	result, err := neo4j.ExecuteQuery(ctx, n.Driver,
-		"MATCH (p:Person)-[:ACTED_IN]->(m:Movie {ID: $id) return p, m",
-		map[string]any{
-			"ID": movieID,
-		}, neo4j.EagerResultTransformer)
-
+func (n Neo4jProfessionRepo) Add(ctx context.Context, m *domain.Profession) error {
+	role, err := domain.RoleByID(m.Role)
 	if err != nil {
-		logrus.Error(err)
-		return nil, err
+		return err
 	}
 
-	resultProfessions := make([]domain.Profession, 0)
-
-	for _, record := range result.Records {
-		personNode, _, err := neo4j.GetRecordValue[neo4j.Node](record, "p")
-		if err != nil {
-			return nil, err
-		}
-
-		movieNode, _, err := neo4j.GetRecordValue[neo4j.Node](record, "m")
-		if err != nil {
-			return nil, err
-		}
-
-		profession := domain.Profession{}
-
-		personID, _ := neo4j.GetProperty[int64](personNode, "ID")
-		profession.PersonID = uint64(personID)
-
-		movieID, _ := neo4j.GetProperty[int64](movieNode, "ID")
-		profession.MovieID = uint64(movieID)
-
-		profession.Role = domain.ActorsRole
-
-		resultProfessions = append(resultProfessions, profession)
-	}
+	_, err = neo4j.ExecuteQuery(ctx, n.Driver,
+		"MATCH (p:Person {ID:$personID}), (m:Movie {ID:$movieID}) MERGE (p)-[r:"+role.RelationType+"]->(m) "+
+			"SET r.Character = $character, r.Position = $position",
+		map[string]any{
+			"personID":  m.PersonID,
+			"movieID":   m.MovieID,
+			"character": m.Character,
+			"position":  m.Position,
+		}, neo4j.EagerResultTransformer)
 
-	return resultProfessions, nil
+	return err
 }
 
-func (n Neo4jProfessionRepo) Add(ctx context.Context, movieID, personID, role uint64) error {
-	var strRole string
-	switch role {
-	case domain.DirectorRole:
-		strRole = "DIRECTED"
-	case domain.ProducerRole:
-		strRole = "PRODUCED"
-	case domain.WriterRole:
-		strRole = "WROTE"
-	default:
-		strRole = "ACTED_IN"
-
+func (n Neo4jProfessionRepo) Remove(ctx context.Context, movieID, personID, role uint64) error {
+	relation, err := domain.RoleByID(role)
+	if err != nil {
+		return err
 	}
 
-	_, err := neo4j.ExecuteQuery(ctx, n.Driver,
-		"MATCH (p:Person {ID:$personID}), (m:Movie {ID:$movieID}) CREATE (p)-[:$role]->(m)",
+	_, err = neo4j.ExecuteQuery(ctx, n.Driver,
+		"MATCH (p:Person {ID:$personID})-[r:"+relation.RelationType+"]->(m:Movie {ID:$movieID}) DELETE r",
 		map[string]any{
 			"personID": personID,
 			"movieID":  movieID,
-			"role":     strRole,
 		}, neo4j.EagerResultTransformer)
 
 	return err
 }
 
+// SyncRoles has nothing to write, in Neo4j the roles are relationship types.
+func (n Neo4jProfessionRepo) SyncRoles(ctx context.Context, roles []domain.Role) error {
+	return nil
+}
+
 func (n Neo4jProfessionRepo) Delete(ctx context.Context, id uint64) error {
 	return nil
 }
@@ -4,6 +4,7 @@ import (
 	"Kinopoisk-Parser/internal/domain"
 	"context"
 	"database/sql"
+	"github.com/lib/pq"
 	"github.com/sirupsen/logrus"
 )
 
@@ -16,7 +17,7 @@ func New(conn *sql.DB) domain.ProfessionRepository {
 }
 
 func (p pgProfessionalRepo) GetIDByParams(ctx context.Context, movieID, personID, role uint64) (uint64, error) {
-	query := `SELECT id, movie_id, person_id, movie_role FROM professions WHERE movie_id = $1 AND person_id = $2 AND movie_role = $3;`
+	query := `SELECT id, movie_id, person_id, movie_role, character_name, position FROM professions WHERE movie_id = $1 AND person_id = $2 AND movie_role = $3;`
 
 	rows, err := p.Conn.QueryContext(ctx, query, movieID, personID, role)
 	if err != nil {
@@ -37,7 +38,9 @@ func (p pgProfessionalRepo) GetIDByParams(ctx context.Context, movieID, personID
 			&tmpProfession.ID,
 			&tmpProfession.MovieID,
 			&tmpProfession.PersonID,
-			&tmpProfession.Role)
+			&tmpProfession.Role,
+			&tmpProfession.Character,
+			&tmpProfession.Position)
 
 		if err != nil {
 			logrus.Errorf("Repo error: %v", err)
@@ -55,7 +58,7 @@ func (p pgProfessionalRepo) GetIDByParams(ctx context.Context, movieID, personID
 }
 
 func (p pgProfessionalRepo) GetByID(ctx context.Context, id uint64) (domain.Profession, error) {
-	query := `SELECT id, movie_id, person_id, movie_role FROM professions WHERE id = $1;`
+	query := `SELECT id, movie_id, person_id, movie_role, character_name, position FROM professions WHERE id = $1;`
 
 	rows, err := p.Conn.QueryContext(ctx, query, id)
 	if err != nil {
@@ -76,7 +79,9 @@ func (p pgProfessionalRepo) GetByID(ctx context.Context, id uint64) (domain.Prof
 			&tmpProfession.ID,
 			&tmpProfession.MovieID,
 			&tmpProfession.PersonID,
-			&tmpProfession.Role)
+			&tmpProfession.Role,
+			&tmpProfession.Character,
+			&tmpProfession.Position)
 
 		if err != nil {
 			logrus.Errorf("Repo error: %v", err)
@@ -93,10 +98,10 @@ func (p pgProfessionalRepo) GetByID(ctx context.Context, id uint64) (domain.Prof
 	return tmpProfession, err
 }
 
-func (p pgProfessionalRepo) GetDirectorsByMovie(ctx context.Context, movieID uint64) ([]domain.Profession, error) {
-	query := `SELECT id, movie_id, person_id, movie_role FROM professions WHERE movie_id = $1 AND movie_role = $2;`
+func (p pgProfessionalRepo) GetByMovie(ctx context.Context, movieID, role uint64) ([]domain.Profession, error) {
+	query := `SELECT id, movie_id, person_id, movie_role, character_name, position FROM professions WHERE movie_id = $1 AND movie_role = $2 ORDER BY position, id;`
 
-	rows, err := p.Conn.QueryContext(ctx, query, movieID, domain.DirectorRole)
+	rows, err := p.Conn.QueryContext(ctx, query, movieID, role)
 	if err != nil {
 		logrus.Errorf("Repo error: %v", err)
 		return nil, err
@@ -116,7 +121,9 @@ func (p pgProfessionalRepo) GetDirectorsByMovie(ctx context.Context, movieID uin
 			&tmpProfession.ID,
 			&tmpProfession.MovieID,
 			&tmpProfession.PersonID,
-			&tmpProfession.Role)
+			&tmpProfession.Role,
+			&tmpProfession.Character,
+			&tmpProfession.Position)
 
 		if err != nil {
 			logrus.Errorf("Repo error: %v", err)
@@ -129,126 +136,66 @@ func (p pgProfessionalRepo) GetDirectorsByMovie(ctx context.Context, movieID uin
 	return result, err
 }
 
-func (p pgProfessionalRepo) GetProducersByMovie(ctx context.Context, movieID uint64) ([]domain.Profession, error) {
-	query := `SELECT id, movie_id, person_id, movie_role FROM professions WHERE movie_id = $1 AND movie_role = $2;`
+func (p pgProfessionalRepo) Add(ctx context.Context, m *domain.Profession) error {
+	query := `INSERT into professions(movie_id, person_id, movie_role, character_name, position) VALUES ($1, $2, $3, $4, $5);`
 
-	rows, err := p.Conn.QueryContext(ctx, query, movieID, domain.ProducerRole)
-	if err != nil {
-		logrus.Errorf("Repo error: %v", err)
-		return nil, err
-	}
-
-	defer func() {
-		err := rows.Close()
-		if err != nil {
-			logrus.Errorf("Repo closing error: %v", err)
-		}
-	}()
-
-	result := make([]domain.Profession, 0)
-	for rows.Next() {
-		tmpProfession := domain.Profession{}
-		err = rows.Scan(
-			&tmpProfession.ID,
-			&tmpProfession.MovieID,
-			&tmpProfession.PersonID,
-			&tmpProfession.Role)
-
-		if err != nil {
-			logrus.Errorf("Repo error: %v", err)
-			return nil, err
-		}
-
-		result = append(result, tmpProfession)
-	}
+	_, err := p.Conn.ExecContext(ctx, query, m.MovieID, m.PersonID, m.Role, m.Character, m.Position)
 
-	return result, err
+	return err
 }
 
-func (p pgProfessionalRepo) GetWritersByMovie(ctx context.Context, movieID uint64) ([]domain.Profession, error) {
-	query := `SELECT id, movie_id, person_id, movie_role FROM professions WHERE movie_id = $1 AND movie_role = $2;`
+func (p pgProfessionalRepo) Remove(ctx context.Context, movieID, personID, role uint64) error {
+	query := `DELETE FROM professions WHERE movie_id = $1 AND person_id = $2 AND movie_role = $3;`
 
-	rows, err := p.Conn.QueryContext(ctx, query, movieID, domain.WriterRole)
-	if err != nil {
-		logrus.Errorf("Repo error: %v", err)
-		return nil, err
-	}
-
-	defer func() {
-		err := rows.Close()
-		if err != nil {
-			logrus.Errorf("Repo closing error: %v", err)
-		}
-	}()
+	_, err := p.Conn.ExecContext(ctx, query, movieID, personID, role)
 
-	result := make([]domain.Profession, 0)
-	for rows.Next() {
-		tmpProfession := domain.Profession{}
-		err = rows.Scan(
-			&tmpProfession.ID,
-			&tmpProfession.MovieID,
-			&tmpProfession.PersonID,
-			&tmpProfession.Role)
+	return err
+}
 
-		if err != nil {
-			logrus.Errorf("Repo error: %v", err)
-			return nil, err
-		}
+func (p pgProfessionalRepo) Delete(ctx context.Context, id uint64) error {
+	query := `DELETE FROM professions WHERE id = $1;`
 
-		result = append(result, tmpProfession)
-	}
+	_, err := p.Conn.ExecContext(ctx, query, id)
 
-	return result, err
+	return err
 }
 
-func (p pgProfessionalRepo) GetActorsByMovie(ctx context.Context, movieID uint64) ([]domain.Profession, error) {
-	query := `SELECT id, movie_id, person_id, movie_role FROM professions WHERE movie_id = $1 AND movie_role = $2;`
-
-	rows, err := p.Conn.QueryContext(ctx, query, movieID, domain.ActorsRole)
+// SyncRoles writes the catalogue into the roles table and drops the rows that
+// are no longer in it, a role still referenced by professions fails the sync.
+func (p pgProfessionalRepo) SyncRoles(ctx context.Context, roles []domain.Role) error {
+	tx, err := p.Conn.BeginTx(ctx, nil)
 	if err != nil {
 		logrus.Errorf("Repo error: %v", err)
-		return nil, err
+		return err
 	}
 
 	defer func() {
-		err := rows.Close()
-		if err != nil {
-			logrus.Errorf("Repo closing error: %v", err)
+		err := tx.Rollback()
+		if err != nil && err != sql.ErrTxDone {
+			logrus.Errorf("Repo rollback error: %v", err)
 		}
 	}()
 
-	result := make([]domain.Profession, 0)
-	for rows.Next() {
-		tmpProfession := domain.Profession{}
-		err = rows.Scan(
-			&tmpProfession.ID,
-			&tmpProfession.MovieID,
-			&tmpProfession.PersonID,
-			&tmpProfession.Role)
+	query := `INSERT into roles(id, name, en_name, relation_type) VALUES ($1, $2, $3, $4)
+			 ON CONFLICT (id) DO UPDATE SET name = excluded.name, en_name = excluded.en_name,
+			 relation_type = excluded.relation_type;`
 
+	ids := make([]int64, 0, len(roles))
+	for _, role := range roles {
+		_, err = tx.ExecContext(ctx, query, role.ID, role.Name, role.EnName, role.RelationType)
 		if err != nil {
-			logrus.Errorf("Repo error: %v", err)
-			return nil, err
+			return err
 		}
 
-		result = append(result, tmpProfession)
+		ids = append(ids, int64(role.ID))
 	}
 
-	return result, err
-}
-
-func (p pgProfessionalRepo) Add(ctx context.Context, movieID, personID, role uint64) error {
-	query := `INSERT into professions(movie_id, person_id, movie_role) VALUES ($1, $2, $3);`
-
-	_, err := p.Conn.ExecContext(ctx, query, movieID, personID, role)
-
-	return err
-}
+	query = `DELETE FROM roles WHERE id <> ALL($1);`
 
-func (p pgProfessionalRepo) Delete(ctx context.Context, id uint64) error {
-	query := `DELETE FROM professions WHERE id = $1;`
-
-	_, err := p.Conn.ExecContext(ctx, query, id)
+	_, err = tx.ExecContext(ctx, query, pq.Array(ids))
+	if err != nil {
+		return err
+	}
 
-	return err
+	return tx.Commit()
 }
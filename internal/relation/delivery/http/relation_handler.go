@@ -0,0 +1,51 @@
+package http
+
+import (
+	"Kinopoisk-Parser/internal/domain"
+	"context"
+	"encoding/json"
+	"github.com/gorilla/mux"
+	"github.com/sirupsen/logrus"
+	"net/http"
+	"strconv"
+)
+
+type RelationHandler struct {
+	RUsecase domain.RelationUsecase
+}
+
+func NewRelationHandler(usecase domain.RelationUsecase) RelationHandler {
+	return RelationHandler{RUsecase: usecase}
+}
+
+func (h *RelationHandler) GetRelated(w http.ResponseWriter, r *http.Request) {
+	movieID, err := strconv.ParseUint(mux.Vars(r)["id"], 10, 64)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		logrus.Error("Bad request: movie id")
+		return
+	}
+
+	relations, err := h.RUsecase.GetRelated(context.Background(), movieID, r.URL.Query().Get("type"))
+	switch err {
+	case domain.UnknownRelation:
+		w.WriteHeader(http.StatusBadRequest)
+		logrus.Errorf("Bad request: %v", err)
+		return
+	case nil:
+
+	default:
+		w.WriteHeader(http.StatusInternalServerError)
+		logrus.Errorf("Error while get related: %v", err)
+		return
+	}
+
+	relationsRaw, err := json.Marshal(relations)
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		logrus.Errorf("Error while marshal: %v", err)
+		return
+	}
+
+	w.Write(relationsRaw)
+}
@@ -0,0 +1,65 @@
+package http
+
+import (
+	"Kinopoisk-Parser/internal/domain"
+	"context"
+	"encoding/json"
+	"errors"
+	"github.com/gorilla/mux"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+type fakeRelations struct {
+	domain.RelationUsecase
+	relationType string
+	err          error
+}
+
+func (f *fakeRelations) GetRelated(ctx context.Context, movieID uint64, relationType string) ([]domain.MovieRelation, error) {
+	f.relationType = relationType
+
+	return []domain.MovieRelation{{MovieID: movieID, RelatedID: 302, Type: domain.SequelRelation}}, f.err
+}
+
+func serve(usecase domain.RelationUsecase, target string) *httptest.ResponseRecorder {
+	handler := NewRelationHandler(usecase)
+
+	r := mux.NewRouter()
+	r.HandleFunc("/movies/{id:[0-9]+}/related", handler.GetRelated).Methods("GET")
+
+	recorder := httptest.NewRecorder()
+	r.ServeHTTP(recorder, httptest.NewRequest("GET", target, nil))
+
+	return recorder
+}
+
+func TestGetRelated(t *testing.T) {
+	usecase := &fakeRelations{}
+
+	recorder := serve(usecase, "/movies/301/related?type=sequel")
+	if recorder.Code != http.StatusOK {
+		t.Fatalf("status = %d", recorder.Code)
+	}
+	if usecase.relationType != domain.SequelRelation {
+		t.Errorf("type = %q", usecase.relationType)
+	}
+
+	var relations []domain.MovieRelation
+	if err := json.Unmarshal(recorder.Body.Bytes(), &relations); err != nil {
+		t.Fatalf("unmarshal: %v", err)
+	}
+	if len(relations) != 1 || relations[0].MovieID != 301 || relations[0].RelatedID != 302 {
+		t.Errorf("relations = %+v", relations)
+	}
+}
+
+func TestGetRelatedErrors(t *testing.T) {
+	if code := serve(&fakeRelations{err: domain.UnknownRelation}, "/movies/301/related?type=remake").Code; code != http.StatusBadRequest {
+		t.Errorf("unknown type: status = %d", code)
+	}
+	if code := serve(&fakeRelations{err: errors.New("connection refused")}, "/movies/301/related").Code; code != http.StatusInternalServerError {
+		t.Errorf("usecase error: status = %d", code)
+	}
+}
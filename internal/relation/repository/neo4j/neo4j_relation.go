@@ -0,0 +1,132 @@
+package neo4jRelationRepo
+
+import (
+	"Kinopoisk-Parser/internal/domain"
+	"context"
+	"github.com/neo4j/neo4j-go-driver/v5/neo4j"
+	"github.com/sirupsen/logrus"
+)
+
+type Neo4jRelationRepo struct {
+	Driver neo4j.DriverWithContext
+}
+
+func New(driver neo4j.DriverWithContext) domain.RelationRepository {
+	return &Neo4jRelationRepo{Driver: driver}
+}
+
+// GetByMovie returns the relations of the given type, or all of them when the
+// type is empty.
+func (n Neo4jRelationRepo) GetByMovie(ctx context.Context, movieID uint64, relationType string) ([]domain.MovieRelation, error) {
+	resultRelations := make([]domain.MovieRelation, 0)
+
+	for _, name := range []string{domain.SequelRelation, domain.SimilarRelation} {
+		if relationType != "" && relationType != name {
+			continue
+		}
+
+		relations, err := n.getByType(ctx, movieID, name)
+		if err != nil {
+			return nil, err
+		}
+
+		resultRelations = append(resultRelations, relations...)
+	}
+
+	return resultRelations, nil
+}
+
+func (n Neo4jRelationRepo) getByType(ctx context.Context, movieID uint64, relationType string) ([]domain.MovieRelation, error) {
+	result, err := neo4j.ExecuteQuery(ctx, n.Driver,
+		"MATCH (m:Movie {ID: $id})-[l:"+domain.MovieRelationTypes[relationType]+"]->(r:Movie) "+
+			"return l, r ORDER BY l.Position",
+		map[string]any{
+			"id": movieID,
+		}, neo4j.EagerResultTransformer)
+	if err != nil {
+		logrus.Error(err)
+		return nil, err
+	}
+
+	resultRelations := make([]domain.MovieRelation, 0)
+
+	for _, record := range result.Records {
+		link, _, err := neo4j.GetRecordValue[neo4j.Relationship](record, "l")
+		if err != nil {
+			return nil, err
+		}
+
+		relatedNode, _, err := neo4j.GetRecordValue[neo4j.Node](record, "r")
+		if err != nil {
+			return nil, err
+		}
+
+		relation := domain.MovieRelation{MovieID: movieID, Type: relationType}
+
+		relatedID, _ := neo4j.GetProperty[int64](relatedNode, "ID")
+		relation.RelatedID = uint64(relatedID)
+
+		relation.Name, _ = neo4j.GetProperty[string](link, "Name")
+
+		year, _ := neo4j.GetProperty[int64](link, "Year")
+		relation.Year = uint64(year)
+
+		resultRelations = append(resultRelations, relation)
+	}
+
+	return resultRelations, nil
+}
+
+// Replace swaps the stored relations of one type for the given ones. A related
+// movie that is not stored yet gets a node with the ID only, it is filled in
+// when the crawl reaches it.
+func (n Neo4jRelationRepo) Replace(ctx context.Context, movieID uint64, relationType string,
+	relations []domain.MovieRelation) error {
+	linkType := domain.MovieRelationTypes[relationType]
+
+	links := make([]any, 0, len(relations))
+	for i, relation := range relations {
+		links = append(links, map[string]any{
+			"RelatedID": relation.RelatedID,
+			"Name":      relation.Name,
+			"Year":      relation.Year,
+			"Position":  i + 1,
+		})
+	}
+
+	params := map[string]any{
+		"id":    movieID,
+		"links": links,
+	}
+
+	session := n.Driver.NewSession(ctx, neo4j.SessionConfig{})
+	defer func() {
+		err := session.Close(ctx)
+		if err != nil {
+			logrus.Errorf("Repo closing error: %v", err)
+		}
+	}()
+
+	// The old links are deleted in the same transaction, so they stay if the write fails.
+	_, err := session.ExecuteWrite(ctx, func(tx neo4j.ManagedTransaction) (any, error) {
+		result, err := tx.Run(ctx, "MATCH (m:Movie {ID: $id})-[l:"+linkType+"]->() DELETE l", params)
+		if err != nil {
+			return nil, err
+		}
+		_, err = result.Consume(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		result, err = tx.Run(ctx,
+			"MATCH (m:Movie {ID: $id}) UNWIND $links AS link MERGE (r:Movie {ID: link.RelatedID}) "+
+				"MERGE (m)-[l:"+linkType+"]->(r) SET l.Name = link.Name, l.Year = link.Year, l.Position = link.Position", params)
+		if err != nil {
+			return nil, err
+		}
+
+		return result.Consume(ctx)
+	})
+
+	return err
+}
@@ -0,0 +1,94 @@
+package postgresql
+
+import (
+	"Kinopoisk-Parser/internal/domain"
+	"context"
+	"database/sql"
+	"github.com/sirupsen/logrus"
+)
+
+type pgRelationRepo struct {
+	Conn *sql.DB
+}
+
+func New(conn *sql.DB) domain.RelationRepository {
+	return &pgRelationRepo{Conn: conn}
+}
+
+// GetByMovie returns the relations of the given type, or all of them when the
+// type is empty.
+func (p pgRelationRepo) GetByMovie(ctx context.Context, movieID uint64, relationType string) ([]domain.MovieRelation, error) {
+	query := `SELECT movie_id, related_id, relation_type, name, movie_year FROM movie_relations
+			 WHERE movie_id = $1 AND ($2 = '' OR relation_type = $2) ORDER BY relation_type, position;`
+
+	rows, err := p.Conn.QueryContext(ctx, query, movieID, relationType)
+	if err != nil {
+		logrus.Errorf("Repo error: %v", err)
+		return nil, err
+	}
+
+	defer func() {
+		err := rows.Close()
+		if err != nil {
+			logrus.Errorf("Repo closing error: %v", err)
+		}
+	}()
+
+	result := make([]domain.MovieRelation, 0)
+	for rows.Next() {
+		tmpRelation := domain.MovieRelation{}
+		err = rows.Scan(
+			&tmpRelation.MovieID,
+			&tmpRelation.RelatedID,
+			&tmpRelation.Type,
+			&tmpRelation.Name,
+			&tmpRelation.Year)
+
+		if err != nil {
+			logrus.Errorf("Repo error: %v", err)
+			return nil, err
+		}
+
+		result = append(result, tmpRelation)
+	}
+
+	return result, err
+}
+
+// Replace swaps the stored relations of one type for the given ones, keeping
+// their order.
+func (p pgRelationRepo) Replace(ctx context.Context, movieID uint64, relationType string,
+	relations []domain.MovieRelation) error {
+	tx, err := p.Conn.BeginTx(ctx, nil)
+	if err != nil {
+		logrus.Errorf("Repo error: %v", err)
+		return err
+	}
+
+	defer func() {
+		err := tx.Rollback()
+		if err != nil && err != sql.ErrTxDone {
+			logrus.Errorf("Repo rollback error: %v", err)
+		}
+	}()
+
+	query := `DELETE FROM movie_relations WHERE movie_id = $1 AND relation_type = $2;`
+
+	_, err = tx.ExecContext(ctx, query, movieID, relationType)
+	if err != nil {
+		return err
+	}
+
+	query = `INSERT into movie_relations(movie_id, related_id, relation_type, name, movie_year, position)
+			 VALUES ($1, $2, $3, $4, $5, $6) ON CONFLICT DO NOTHING;`
+
+	for i, relation := range relations {
+		_, err = tx.ExecContext(ctx, query, movieID, relation.RelatedID, relationType, relation.Name, relation.Year,
+			i+1)
+		if err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
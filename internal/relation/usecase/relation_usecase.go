@@ -0,0 +1,65 @@
+package usecase
+
+import (
+	"Kinopoisk-Parser/internal/domain"
+	"context"
+	"fmt"
+	"github.com/sirupsen/logrus"
+	"time"
+)
+
+type relationUsecase struct {
+	relationRepo   domain.RelationRepository
+	contextTimeout time.Duration
+}
+
+func NewRelationUsecase(r domain.RelationRepository, timeout time.Duration) domain.RelationUsecase {
+	return &relationUsecase{
+		relationRepo:   r,
+		contextTimeout: timeout,
+	}
+}
+
+func (u *relationUsecase) GetRelated(ctx context.Context, movieID uint64, relationType string) ([]domain.MovieRelation, error) {
+	if _, ok := domain.MovieRelationTypes[relationType]; relationType != "" && !ok {
+		return nil, domain.UnknownRelation
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, u.contextTimeout)
+	defer cancel()
+
+	relations, err := u.relationRepo.GetByMovie(ctx, movieID, relationType)
+	if err != nil {
+		logrus.Errorf("Usecase: %v", err)
+		return nil, fmt.Errorf("usecase: %v", err)
+	}
+
+	return relations, nil
+}
+
+// Save replaces every relation type of the movie, so a relation missing from
+// a refreshed payload is removed.
+func (u *relationUsecase) Save(ctx context.Context, movieID uint64, relations []domain.MovieRelation) error {
+	ctx, cancel := context.WithTimeout(ctx, u.contextTimeout)
+	defer cancel()
+
+	byType := map[string][]domain.MovieRelation{}
+	for _, relation := range relations {
+		if _, ok := domain.MovieRelationTypes[relation.Type]; !ok {
+			logrus.Errorf("Usecase: %v %q", domain.UnknownRelation, relation.Type)
+			return fmt.Errorf("usecase: %v", domain.UnknownRelation)
+		}
+
+		byType[relation.Type] = append(byType[relation.Type], relation)
+	}
+
+	for relationType := range domain.MovieRelationTypes {
+		err := u.relationRepo.Replace(ctx, movieID, relationType, byType[relationType])
+		if err != nil {
+			logrus.Errorf("Usecase: %v", err)
+			return fmt.Errorf("usecase: %v", err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,62 @@
+package usecase
+
+import (
+	"Kinopoisk-Parser/internal/domain"
+	"context"
+	"testing"
+	"time"
+)
+
+type fakeRelations struct {
+	domain.RelationRepository
+	replaced map[string][]domain.MovieRelation
+}
+
+func (f *fakeRelations) GetByMovie(ctx context.Context, movieID uint64, relationType string) ([]domain.MovieRelation, error) {
+	return f.replaced[relationType], nil
+}
+
+func (f *fakeRelations) Replace(ctx context.Context, movieID uint64, relationType string,
+	relations []domain.MovieRelation) error {
+	f.replaced[relationType] = relations
+
+	return nil
+}
+
+func TestSaveReplacesEveryType(t *testing.T) {
+	repo := &fakeRelations{replaced: map[string][]domain.MovieRelation{}}
+	u := NewRelationUsecase(repo, time.Second)
+
+	err := u.Save(context.Background(), 301, []domain.MovieRelation{
+		{MovieID: 301, RelatedID: 302, Type: domain.SequelRelation},
+		{MovieID: 301, RelatedID: 303, Type: domain.SequelRelation},
+	})
+	if err != nil {
+		t.Fatalf("save: %v", err)
+	}
+
+	if len(repo.replaced[domain.SequelRelation]) != 2 {
+		t.Errorf("sequels = %+v", repo.replaced[domain.SequelRelation])
+	}
+	if similar, ok := repo.replaced[domain.SimilarRelation]; !ok || len(similar) != 0 {
+		t.Errorf("similar = %+v, a type missing from the payload is cleared", similar)
+	}
+}
+
+func TestSaveUnknownType(t *testing.T) {
+	repo := &fakeRelations{replaced: map[string][]domain.MovieRelation{}}
+	u := NewRelationUsecase(repo, time.Second)
+
+	err := u.Save(context.Background(), 301, []domain.MovieRelation{{MovieID: 301, RelatedID: 302, Type: "remake"}})
+	if err == nil || len(repo.replaced) != 0 {
+		t.Errorf("err = %v, replaced = %+v", err, repo.replaced)
+	}
+}
+
+func TestGetRelatedUnknownType(t *testing.T) {
+	u := NewRelationUsecase(&fakeRelations{}, time.Second)
+
+	if _, err := u.GetRelated(context.Background(), 301, "remake"); err != domain.UnknownRelation {
+		t.Errorf("err = %v", err)
+	}
+}
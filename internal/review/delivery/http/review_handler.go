@@ -0,0 +1,54 @@
+package http
+
+import (
+	"Kinopoisk-Parser/internal/delivery/query"
+	"Kinopoisk-Parser/internal/domain"
+	"context"
+	"encoding/json"
+	"github.com/gorilla/mux"
+	"github.com/sirupsen/logrus"
+	"net/http"
+	"strconv"
+)
+
+type ReviewHandler struct {
+	RUsecase domain.ReviewUsecase
+}
+
+func NewReviewHandler(usecase domain.ReviewUsecase) ReviewHandler {
+	return ReviewHandler{RUsecase: usecase}
+}
+
+func (h *ReviewHandler) GetReviews(w http.ResponseWriter, r *http.Request) {
+	movieID, err := strconv.ParseUint(mux.Vars(r)["id"], 10, 64)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		logrus.Error("Bad request: movie id")
+		return
+	}
+
+	limit, offset := query.Page(r)
+
+	reviews, err := h.RUsecase.GetReviews(context.Background(), movieID, r.URL.Query().Get("type"), limit, offset)
+	switch err {
+	case domain.UnknownReviewType:
+		w.WriteHeader(http.StatusBadRequest)
+		logrus.Errorf("Bad request: %v", err)
+		return
+	case nil:
+
+	default:
+		w.WriteHeader(http.StatusInternalServerError)
+		logrus.Errorf("Error while get reviews: %v", err)
+		return
+	}
+
+	reviewsRaw, err := json.Marshal(reviews)
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		logrus.Errorf("Error while marshal: %v", err)
+		return
+	}
+
+	w.Write(reviewsRaw)
+}
@@ -0,0 +1,78 @@
+package http
+
+import (
+	"Kinopoisk-Parser/internal/domain"
+	"context"
+	"encoding/json"
+	"errors"
+	"github.com/gorilla/mux"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+type fakeReviews struct {
+	domain.ReviewUsecase
+	movieID       uint64
+	reviewType    string
+	limit, offset uint64
+	err           error
+}
+
+func (f *fakeReviews) GetReviews(ctx context.Context, movieID uint64, reviewType string,
+	limit, offset uint64) ([]domain.Review, error) {
+	f.movieID, f.reviewType, f.limit, f.offset = movieID, reviewType, limit, offset
+
+	return []domain.Review{{ID: 1, MovieID: movieID, Type: reviewType}}, f.err
+}
+
+func serve(usecase domain.ReviewUsecase, target string) *httptest.ResponseRecorder {
+	handler := NewReviewHandler(usecase)
+
+	r := mux.NewRouter()
+	r.HandleFunc("/movies/{id:[0-9]+}/reviews", handler.GetReviews).Methods("GET")
+
+	recorder := httptest.NewRecorder()
+	r.ServeHTTP(recorder, httptest.NewRequest("GET", target, nil))
+
+	return recorder
+}
+
+func TestGetReviews(t *testing.T) {
+	usecase := &fakeReviews{}
+
+	recorder := serve(usecase, "/movies/301/reviews?type=negative&limit=5&offset=10")
+	if recorder.Code != http.StatusOK {
+		t.Fatalf("status = %d", recorder.Code)
+	}
+	if usecase.movieID != 301 || usecase.reviewType != domain.NegativeReview ||
+		usecase.limit != 5 || usecase.offset != 10 {
+		t.Errorf("usecase is called with %+v", usecase)
+	}
+
+	var reviews []domain.Review
+	if err := json.Unmarshal(recorder.Body.Bytes(), &reviews); err != nil {
+		t.Fatalf("unmarshal: %v", err)
+	}
+	if len(reviews) != 1 || reviews[0].MovieID != 301 {
+		t.Errorf("reviews = %+v", reviews)
+	}
+}
+
+func TestGetReviewsDefaultPage(t *testing.T) {
+	usecase := &fakeReviews{}
+
+	serve(usecase, "/movies/301/reviews")
+	if usecase.reviewType != "" || usecase.limit != 20 || usecase.offset != 0 {
+		t.Errorf("usecase is called with %+v", usecase)
+	}
+}
+
+func TestGetReviewsErrors(t *testing.T) {
+	if code := serve(&fakeReviews{err: domain.UnknownReviewType}, "/movies/301/reviews?type=mixed").Code; code != http.StatusBadRequest {
+		t.Errorf("unknown type: status = %d", code)
+	}
+	if code := serve(&fakeReviews{err: errors.New("connection refused")}, "/movies/301/reviews").Code; code != http.StatusInternalServerError {
+		t.Errorf("usecase error: status = %d", code)
+	}
+}
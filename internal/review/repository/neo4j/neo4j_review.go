@@ -0,0 +1,120 @@
+package neo4jReviewRepo
+
+import (
+	"Kinopoisk-Parser/internal/domain"
+	"context"
+	"github.com/neo4j/neo4j-go-driver/v5/neo4j"
+	"github.com/sirupsen/logrus"
+	"time"
+)
+
+type Neo4jReviewRepo struct {
+	Driver neo4j.DriverWithContext
+}
+
+func New(driver neo4j.DriverWithContext) domain.ReviewRepository {
+	return &Neo4jReviewRepo{Driver: driver}
+}
+
+func (n Neo4jReviewRepo) GetByMovie(ctx context.Context, movieID uint64, reviewType string,
+	limit, offset uint64) ([]domain.Review, error) {
+	result, err := neo4j.ExecuteQuery(ctx, n.Driver,
+		"MATCH (r:Review)-[:REVIEW_OF]->(m:Movie {ID: $id}) WHERE $type = '' OR r.Type = $type "+
+			"RETURN r ORDER BY r.Date DESC, r.ID SKIP $offset LIMIT $limit",
+		map[string]any{
+			"id":     movieID,
+			"type":   reviewType,
+			"limit":  limit,
+			"offset": offset,
+		}, neo4j.EagerResultTransformer)
+	if err != nil {
+		logrus.Error(err)
+		return nil, err
+	}
+
+	resultReviews := make([]domain.Review, 0)
+
+	for _, record := range result.Records {
+		itemNode, _, err := neo4j.GetRecordValue[neo4j.Node](record, "r")
+		if err != nil {
+			return nil, err
+		}
+
+		review := domain.Review{MovieID: movieID}
+
+		id, _ := neo4j.GetProperty[int64](itemNode, "ID")
+		review.ID = uint64(id)
+
+		review.Title, _ = neo4j.GetProperty[string](itemNode, "Title")
+		review.Review, _ = neo4j.GetProperty[string](itemNode, "Review")
+		review.Type, _ = neo4j.GetProperty[string](itemNode, "Type")
+		review.Author, _ = neo4j.GetProperty[string](itemNode, "Author")
+
+		date, err := neo4j.GetProperty[time.Time](itemNode, "Date")
+		if err == nil {
+			review.Date = &date
+		}
+
+		resultReviews = append(resultReviews, review)
+	}
+
+	return resultReviews, nil
+}
+
+func (n Neo4jReviewRepo) Replace(ctx context.Context, movieID uint64, reviews []domain.Review) error {
+	props := make([]any, 0, len(reviews))
+	for _, review := range reviews {
+		props = append(props, map[string]any{
+			"ID":     review.ID,
+			"Title":  review.Title,
+			"Review": review.Review,
+			"Type":   review.Type,
+			"Author": review.Author,
+			"Date":   dateProp(review.Date),
+		})
+	}
+
+	params := map[string]any{
+		"id":      movieID,
+		"reviews": props,
+	}
+
+	session := n.Driver.NewSession(ctx, neo4j.SessionConfig{})
+	defer func() {
+		err := session.Close(ctx)
+		if err != nil {
+			logrus.Errorf("Repo closing error: %v", err)
+		}
+	}()
+
+	// One transaction: reviews are never left deleted without the new ones.
+	_, err := session.ExecuteWrite(ctx, func(tx neo4j.ManagedTransaction) (any, error) {
+		result, err := tx.Run(ctx, "MATCH (r:Review)-[:REVIEW_OF]->(:Movie {ID: $id}) DETACH DELETE r", params)
+		if err != nil {
+			return nil, err
+		}
+		_, err = result.Consume(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		result, err = tx.Run(ctx,
+			"MATCH (m:Movie {ID: $id}) UNWIND $reviews AS review "+
+				"MERGE (r:Review {ID: review.ID}) SET r += review MERGE (r)-[:REVIEW_OF]->(m)", params)
+		if err != nil {
+			return nil, err
+		}
+
+		return result.Consume(ctx)
+	})
+
+	return err
+}
+
+func dateProp(date *time.Time) any {
+	if date == nil {
+		return nil
+	}
+
+	return *date
+}
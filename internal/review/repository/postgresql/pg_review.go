@@ -0,0 +1,96 @@
+package postgresql
+
+import (
+	"Kinopoisk-Parser/internal/domain"
+	"context"
+	"database/sql"
+	"github.com/sirupsen/logrus"
+)
+
+const reviewColumns = `id, movie_id, title, review, review_type, author, review_date`
+
+type pgReviewRepo struct {
+	Conn *sql.DB
+}
+
+func New(conn *sql.DB) domain.ReviewRepository {
+	return &pgReviewRepo{Conn: conn}
+}
+
+func (p pgReviewRepo) GetByMovie(ctx context.Context, movieID uint64, reviewType string,
+	limit, offset uint64) ([]domain.Review, error) {
+	query := `SELECT ` + reviewColumns + ` FROM reviews WHERE movie_id = $1 AND ($2 = '' OR review_type = $2)
+			 ORDER BY review_date DESC NULLS LAST, id LIMIT $3 OFFSET $4;`
+
+	rows, err := p.Conn.QueryContext(ctx, query, movieID, reviewType, limit, offset)
+	if err != nil {
+		logrus.Errorf("Repo error: %v", err)
+		return nil, err
+	}
+
+	defer func() {
+		err := rows.Close()
+		if err != nil {
+			logrus.Errorf("Repo closing error: %v", err)
+		}
+	}()
+
+	result := make([]domain.Review, 0)
+	for rows.Next() {
+		tmpReview := domain.Review{}
+		err = rows.Scan(
+			&tmpReview.ID,
+			&tmpReview.MovieID,
+			&tmpReview.Title,
+			&tmpReview.Review,
+			&tmpReview.Type,
+			&tmpReview.Author,
+			&tmpReview.Date)
+
+		if err != nil {
+			logrus.Errorf("Repo error: %v", err)
+			return nil, err
+		}
+
+		result = append(result, tmpReview)
+	}
+
+	return result, err
+}
+
+// Replace swaps the stored reviews of the movie for the given ones in one
+// transaction, so reviews removed upstream are dropped on refresh.
+func (p pgReviewRepo) Replace(ctx context.Context, movieID uint64, reviews []domain.Review) error {
+	tx, err := p.Conn.BeginTx(ctx, nil)
+	if err != nil {
+		logrus.Errorf("Repo error: %v", err)
+		return err
+	}
+
+	defer func() {
+		err := tx.Rollback()
+		if err != nil && err != sql.ErrTxDone {
+			logrus.Errorf("Repo rollback error: %v", err)
+		}
+	}()
+
+	_, err = tx.ExecContext(ctx, `DELETE FROM reviews WHERE movie_id = $1;`, movieID)
+	if err != nil {
+		return err
+	}
+
+	query := `INSERT into reviews(` + reviewColumns + `) VALUES ($1, $2, $3, $4, $5, $6, $7)
+			 ON CONFLICT (id) DO UPDATE SET movie_id = excluded.movie_id, title = excluded.title,
+			 review = excluded.review, review_type = excluded.review_type, author = excluded.author,
+			 review_date = excluded.review_date;`
+
+	for _, review := range reviews {
+		_, err = tx.ExecContext(ctx, query, review.ID, movieID, review.Title, review.Review, review.Type,
+			review.Author, review.Date)
+		if err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
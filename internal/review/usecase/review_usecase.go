@@ -0,0 +1,56 @@
+package usecase
+
+import (
+	"Kinopoisk-Parser/internal/domain"
+	"context"
+	"fmt"
+	"github.com/sirupsen/logrus"
+	"time"
+)
+
+type reviewUsecase struct {
+	reviewRepo     domain.ReviewRepository
+	contextTimeout time.Duration
+}
+
+func NewReviewUsecase(r domain.ReviewRepository, timeout time.Duration) domain.ReviewUsecase {
+	return &reviewUsecase{
+		reviewRepo:     r,
+		contextTimeout: timeout,
+	}
+}
+
+func (u *reviewUsecase) GetReviews(ctx context.Context, movieID uint64, reviewType string,
+	limit, offset uint64) ([]domain.Review, error) {
+	switch reviewType {
+	case "", domain.PositiveReview, domain.NegativeReview, domain.NeutralReview:
+	default:
+		return nil, domain.UnknownReviewType
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, u.contextTimeout)
+	defer cancel()
+
+	reviews, err := u.reviewRepo.GetByMovie(ctx, movieID, reviewType, limit, offset)
+	if err != nil {
+		logrus.Errorf("Usecase: %v", err)
+		return nil, fmt.Errorf("usecase: %v", err)
+	}
+
+	return reviews, nil
+}
+
+// Save replaces the stored reviews of the movie, so a review missing from a
+// refreshed payload is removed.
+func (u *reviewUsecase) Save(ctx context.Context, movieID uint64, reviews []domain.Review) error {
+	ctx, cancel := context.WithTimeout(ctx, u.contextTimeout)
+	defer cancel()
+
+	err := u.reviewRepo.Replace(ctx, movieID, reviews)
+	if err != nil {
+		logrus.Errorf("Usecase: %v", err)
+		return fmt.Errorf("usecase: %v", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,59 @@
+package usecase
+
+import (
+	"Kinopoisk-Parser/internal/domain"
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+type fakeReviewRepo struct {
+	domain.ReviewRepository
+	calls    int
+	replaced []domain.Review
+	err      error
+}
+
+func (f *fakeReviewRepo) GetByMovie(ctx context.Context, movieID uint64, reviewType string,
+	limit, offset uint64) ([]domain.Review, error) {
+	f.calls++
+
+	return []domain.Review{{MovieID: movieID, Type: reviewType}}, f.err
+}
+
+func (f *fakeReviewRepo) Replace(ctx context.Context, movieID uint64, reviews []domain.Review) error {
+	f.replaced = reviews
+
+	return f.err
+}
+
+func TestGetReviewsType(t *testing.T) {
+	repo := &fakeReviewRepo{}
+	u := NewReviewUsecase(repo, time.Second)
+
+	for _, reviewType := range []string{"", domain.PositiveReview, domain.NegativeReview, domain.NeutralReview} {
+		if _, err := u.GetReviews(context.Background(), 301, reviewType, 20, 0); err != nil {
+			t.Errorf("type %q: err = %v", reviewType, err)
+		}
+	}
+
+	if _, err := u.GetReviews(context.Background(), 301, "Позитивный", 20, 0); err != domain.UnknownReviewType {
+		t.Errorf("kinopoisk type: err = %v", err)
+	}
+	if repo.calls != 4 {
+		t.Errorf("repository is queried %d times", repo.calls)
+	}
+}
+
+func TestReviewsRepositoryError(t *testing.T) {
+	repo := &fakeReviewRepo{err: errors.New("connection refused")}
+	u := NewReviewUsecase(repo, time.Second)
+
+	if _, err := u.GetReviews(context.Background(), 301, "", 20, 0); err == nil {
+		t.Error("get reviews: error is lost")
+	}
+	if err := u.Save(context.Background(), 301, []domain.Review{{ID: 1}}); err == nil {
+		t.Error("save: error is lost")
+	}
+}
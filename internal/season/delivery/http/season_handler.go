@@ -0,0 +1,83 @@
+package http
+
+import (
+	"Kinopoisk-Parser/internal/domain"
+	"context"
+	"encoding/json"
+	"github.com/gorilla/mux"
+	"github.com/sirupsen/logrus"
+	"net/http"
+	"strconv"
+)
+
+type SeasonHandler struct {
+	SUsecase domain.SeasonUsecase
+}
+
+func NewSeasonHandler(usecase domain.SeasonUsecase) SeasonHandler {
+	return SeasonHandler{SUsecase: usecase}
+}
+
+func (h *SeasonHandler) GetSeasons(w http.ResponseWriter, r *http.Request) {
+	movieID, err := strconv.ParseUint(mux.Vars(r)["id"], 10, 64)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		logrus.Error("Bad request: movie id")
+		return
+	}
+
+	seasons, err := h.SUsecase.GetSeasons(context.Background(), movieID)
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		logrus.Errorf("Error while get seasons: %v", err)
+		return
+	}
+
+	seasonsRaw, err := json.Marshal(seasons)
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		logrus.Errorf("Error while marshal: %v", err)
+		return
+	}
+
+	w.Write(seasonsRaw)
+}
+
+func (h *SeasonHandler) GetSeason(w http.ResponseWriter, r *http.Request) {
+	movieID, err := strconv.ParseUint(mux.Vars(r)["id"], 10, 64)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		logrus.Error("Bad request: movie id")
+		return
+	}
+
+	number, err := strconv.ParseUint(mux.Vars(r)["number"], 10, 64)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		logrus.Error("Bad request: season number")
+		return
+	}
+
+	season, err := h.SUsecase.GetSeason(context.Background(), movieID, number)
+	switch err {
+	case domain.SeasonNotFound:
+		w.WriteHeader(http.StatusNotFound)
+		logrus.Errorf("season not found: %v", err)
+		return
+	case nil:
+
+	default:
+		w.WriteHeader(http.StatusInternalServerError)
+		logrus.Errorf("Error while get season: %v", err)
+		return
+	}
+
+	seasonRaw, err := json.Marshal(season)
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		logrus.Errorf("Error while marshal: %v", err)
+		return
+	}
+
+	w.Write(seasonRaw)
+}
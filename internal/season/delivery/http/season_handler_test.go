@@ -0,0 +1,88 @@
+package http
+
+import (
+	"Kinopoisk-Parser/internal/domain"
+	"context"
+	"encoding/json"
+	"errors"
+	"github.com/gorilla/mux"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+type fakeSeasons struct {
+	domain.SeasonUsecase
+	err error
+}
+
+func (f fakeSeasons) GetSeasons(ctx context.Context, movieID uint64) ([]domain.Season, error) {
+	return []domain.Season{{MovieID: movieID, Number: 1}, {MovieID: movieID, Number: 2}}, f.err
+}
+
+func (f fakeSeasons) GetSeason(ctx context.Context, movieID, number uint64) (domain.Season, error) {
+	season := domain.Season{
+		MovieID:  movieID,
+		Number:   number,
+		Episodes: []domain.Episode{{MovieID: movieID, SeasonNumber: number, Number: 1}},
+	}
+
+	return season, f.err
+}
+
+func serve(usecase domain.SeasonUsecase, target string) *httptest.ResponseRecorder {
+	handler := NewSeasonHandler(usecase)
+
+	r := mux.NewRouter()
+	r.HandleFunc("/movies/{id:[0-9]+}/seasons", handler.GetSeasons).Methods("GET")
+	r.HandleFunc("/movies/{id:[0-9]+}/seasons/{number:[0-9]+}", handler.GetSeason).Methods("GET")
+
+	recorder := httptest.NewRecorder()
+	r.ServeHTTP(recorder, httptest.NewRequest("GET", target, nil))
+
+	return recorder
+}
+
+func TestGetSeasons(t *testing.T) {
+	recorder := serve(fakeSeasons{}, "/movies/464963/seasons")
+	if recorder.Code != http.StatusOK {
+		t.Fatalf("status = %d", recorder.Code)
+	}
+
+	var seasons []domain.Season
+	if err := json.Unmarshal(recorder.Body.Bytes(), &seasons); err != nil {
+		t.Fatalf("unmarshal: %v", err)
+	}
+	if len(seasons) != 2 || seasons[0].MovieID != 464963 || seasons[0].Episodes != nil {
+		t.Errorf("seasons = %+v", seasons)
+	}
+}
+
+func TestGetSeason(t *testing.T) {
+	recorder := serve(fakeSeasons{}, "/movies/464963/seasons/2")
+	if recorder.Code != http.StatusOK {
+		t.Fatalf("status = %d", recorder.Code)
+	}
+
+	var season domain.Season
+	if err := json.Unmarshal(recorder.Body.Bytes(), &season); err != nil {
+		t.Fatalf("unmarshal: %v", err)
+	}
+	if season.Number != 2 || len(season.Episodes) != 1 || season.Episodes[0].SeasonNumber != 2 {
+		t.Errorf("season = %+v", season)
+	}
+}
+
+func TestGetSeasonErrors(t *testing.T) {
+	if code := serve(fakeSeasons{err: domain.SeasonNotFound}, "/movies/464963/seasons/9").Code; code != http.StatusNotFound {
+		t.Errorf("missing season: status = %d", code)
+	}
+
+	failing := fakeSeasons{err: errors.New("connection refused")}
+	if code := serve(failing, "/movies/464963/seasons/1").Code; code != http.StatusInternalServerError {
+		t.Errorf("season error: status = %d", code)
+	}
+	if code := serve(failing, "/movies/464963/seasons").Code; code != http.StatusInternalServerError {
+		t.Errorf("seasons error: status = %d", code)
+	}
+}
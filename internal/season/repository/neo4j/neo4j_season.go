@@ -0,0 +1,182 @@
+package neo4jSeasonRepo
+
+import (
+	"Kinopoisk-Parser/internal/domain"
+	"context"
+	"github.com/neo4j/neo4j-go-driver/v5/neo4j"
+	"github.com/sirupsen/logrus"
+	"time"
+)
+
+type Neo4jSeasonRepo struct {
+	Driver neo4j.DriverWithContext
+}
+
+func New(driver neo4j.DriverWithContext) domain.SeasonRepository {
+	return &Neo4jSeasonRepo{Driver: driver}
+}
+
+func (n Neo4jSeasonRepo) GetByMovie(ctx context.Context, movieID uint64) ([]domain.Season, error) {
+	result, err := neo4j.ExecuteQuery(ctx, n.Driver,
+		"MATCH (s:Season)-[:SEASON_OF]->(m:Movie {ID: $id}) return s ORDER BY s.Number",
+		map[string]any{
+			"id": movieID,
+		}, neo4j.EagerResultTransformer)
+	if err != nil {
+		logrus.Error(err)
+		return nil, err
+	}
+
+	resultSeasons := make([]domain.Season, 0)
+
+	for _, record := range result.Records {
+		itemNode, _, err := neo4j.GetRecordValue[neo4j.Node](record, "s")
+		if err != nil {
+			return nil, err
+		}
+
+		resultSeasons = append(resultSeasons, seasonFromNode(itemNode))
+	}
+
+	return resultSeasons, nil
+}
+
+func (n Neo4jSeasonRepo) GetByNumber(ctx context.Context, movieID, number uint64) (domain.Season, error) {
+	result, err := neo4j.ExecuteQuery(ctx, n.Driver,
+		"MATCH (s:Season {MovieID: $id, Number: $number}) return s",
+		map[string]any{
+			"id":     movieID,
+			"number": number,
+		}, neo4j.EagerResultTransformer)
+	if err != nil {
+		logrus.Error(err)
+		return domain.Season{}, err
+	}
+
+	if len(result.Records) == 0 {
+		return domain.Season{}, domain.SeasonNotFound
+	}
+
+	itemNode, _, err := neo4j.GetRecordValue[neo4j.Node](result.Records[0], "s")
+	if err != nil {
+		return domain.Season{}, domain.SeasonNotFound
+	}
+
+	return seasonFromNode(itemNode), nil
+}
+
+func seasonFromNode(itemNode neo4j.Node) domain.Season {
+	season := domain.Season{}
+
+	movieID, _ := neo4j.GetProperty[int64](itemNode, "MovieID")
+	season.MovieID = uint64(movieID)
+
+	number, _ := neo4j.GetProperty[int64](itemNode, "Number")
+	season.Number = uint64(number)
+
+	season.Name, _ = neo4j.GetProperty[string](itemNode, "Name")
+	season.EnName, _ = neo4j.GetProperty[string](itemNode, "EnName")
+	season.Description, _ = neo4j.GetProperty[string](itemNode, "Description")
+
+	episodesCount, _ := neo4j.GetProperty[int64](itemNode, "EpisodesCount")
+	season.EpisodesCount = uint64(episodesCount)
+
+	airDate, err := neo4j.GetProperty[time.Time](itemNode, "AirDate")
+	if err == nil {
+		season.AirDate = &airDate
+	}
+
+	return season
+}
+
+func (n Neo4jSeasonRepo) GetEpisodes(ctx context.Context, movieID, season uint64) ([]domain.Episode, error) {
+	result, err := neo4j.ExecuteQuery(ctx, n.Driver,
+		"MATCH (e:Episode)-[:EPISODE_OF]->(s:Season {MovieID: $id, Number: $season}) return e ORDER BY e.Number",
+		map[string]any{
+			"id":     movieID,
+			"season": season,
+		}, neo4j.EagerResultTransformer)
+	if err != nil {
+		logrus.Error(err)
+		return nil, err
+	}
+
+	resultEpisodes := make([]domain.Episode, 0)
+
+	for _, record := range result.Records {
+		itemNode, _, err := neo4j.GetRecordValue[neo4j.Node](record, "e")
+		if err != nil {
+			return nil, err
+		}
+
+		episode := domain.Episode{}
+
+		movieID, _ := neo4j.GetProperty[int64](itemNode, "MovieID")
+		episode.MovieID = uint64(movieID)
+
+		seasonNumber, _ := neo4j.GetProperty[int64](itemNode, "SeasonNumber")
+		episode.SeasonNumber = uint64(seasonNumber)
+
+		number, _ := neo4j.GetProperty[int64](itemNode, "Number")
+		episode.Number = uint64(number)
+
+		episode.Name, _ = neo4j.GetProperty[string](itemNode, "Name")
+		episode.EnName, _ = neo4j.GetProperty[string](itemNode, "EnName")
+		episode.Description, _ = neo4j.GetProperty[string](itemNode, "Description")
+
+		airDate, err := neo4j.GetProperty[time.Time](itemNode, "AirDate")
+		if err == nil {
+			episode.AirDate = &airDate
+		}
+
+		resultEpisodes = append(resultEpisodes, episode)
+	}
+
+	return resultEpisodes, nil
+}
+
+func (n Neo4jSeasonRepo) Save(ctx context.Context, s *domain.Season) error {
+	_, err := neo4j.ExecuteQuery(ctx, n.Driver,
+		"MERGE (s:Season {MovieID: $movieID, Number: $number}) SET s += $props "+
+			"WITH s MATCH (m:Movie {ID: $movieID}) MERGE (s)-[:SEASON_OF]->(m)",
+		map[string]any{
+			"movieID": s.MovieID,
+			"number":  s.Number,
+			"props": map[string]any{
+				"Name":          s.Name,
+				"EnName":        s.EnName,
+				"EpisodesCount": s.EpisodesCount,
+				"AirDate":       dateProp(s.AirDate),
+				"Description":   s.Description,
+			},
+		}, neo4j.EagerResultTransformer)
+
+	return err
+}
+
+func (n Neo4jSeasonRepo) SaveEpisode(ctx context.Context, e *domain.Episode) error {
+	_, err := neo4j.ExecuteQuery(ctx, n.Driver,
+		"MERGE (e:Episode {MovieID: $movieID, SeasonNumber: $season, Number: $number}) SET e += $props "+
+			"WITH e MATCH (s:Season {MovieID: $movieID, Number: $season}) MERGE (e)-[:EPISODE_OF]->(s)",
+		map[string]any{
+			"movieID": e.MovieID,
+			"season":  e.SeasonNumber,
+			"number":  e.Number,
+			"props": map[string]any{
+				"Name":        e.Name,
+				"EnName":      e.EnName,
+				"AirDate":     dateProp(e.AirDate),
+				"Description": e.Description,
+			},
+		}, neo4j.EagerResultTransformer)
+
+	return err
+}
+
+func dateProp(date *time.Time) any {
+	if date == nil {
+		return nil
+	}
+
+	return *date
+}
@@ -0,0 +1,159 @@
+package postgresql
+
+import (
+	"Kinopoisk-Parser/internal/domain"
+	"context"
+	"database/sql"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	seasonColumns  = `movie_id, number, name, en_name, episodes_count, air_date, description`
+	episodeColumns = `movie_id, season_number, number, name, en_name, air_date, description`
+)
+
+type pgSeasonRepo struct {
+	Conn *sql.DB
+}
+
+func New(conn *sql.DB) domain.SeasonRepository {
+	return &pgSeasonRepo{Conn: conn}
+}
+
+func (p pgSeasonRepo) GetByMovie(ctx context.Context, movieID uint64) ([]domain.Season, error) {
+	query := `SELECT ` + seasonColumns + ` FROM seasons WHERE movie_id = $1 ORDER BY number;`
+
+	rows, err := p.Conn.QueryContext(ctx, query, movieID)
+	if err != nil {
+		logrus.Errorf("Repo error: %v", err)
+		return nil, err
+	}
+
+	defer func() {
+		err := rows.Close()
+		if err != nil {
+			logrus.Errorf("Repo closing error: %v", err)
+		}
+	}()
+
+	result := make([]domain.Season, 0)
+	for rows.Next() {
+		tmpSeason, err := scanSeason(rows)
+		if err != nil {
+			logrus.Errorf("Repo error: %v", err)
+			return nil, err
+		}
+
+		result = append(result, tmpSeason)
+	}
+
+	return result, err
+}
+
+func (p pgSeasonRepo) GetByNumber(ctx context.Context, movieID, number uint64) (domain.Season, error) {
+	query := `SELECT ` + seasonColumns + ` FROM seasons WHERE movie_id = $1 AND number = $2;`
+
+	rows, err := p.Conn.QueryContext(ctx, query, movieID, number)
+	if err != nil {
+		logrus.Errorf("Repo error: %v", err)
+		return domain.Season{}, err
+	}
+
+	defer func() {
+		err := rows.Close()
+		if err != nil {
+			logrus.Errorf("Repo closing error: %v", err)
+		}
+	}()
+
+	season := domain.Season{}
+	if rows.Next() {
+		season, err = scanSeason(rows)
+	} else {
+		err = domain.SeasonNotFound
+	}
+
+	if err != nil && err != domain.SeasonNotFound {
+		logrus.Errorf("Repo error: %v", err)
+		return domain.Season{}, err
+	}
+
+	return season, err
+}
+
+func scanSeason(rows *sql.Rows) (domain.Season, error) {
+	season := domain.Season{}
+	err := rows.Scan(
+		&season.MovieID,
+		&season.Number,
+		&season.Name,
+		&season.EnName,
+		&season.EpisodesCount,
+		&season.AirDate,
+		&season.Description)
+
+	return season, err
+}
+
+func (p pgSeasonRepo) GetEpisodes(ctx context.Context, movieID, season uint64) ([]domain.Episode, error) {
+	query := `SELECT ` + episodeColumns + ` FROM episodes WHERE movie_id = $1 AND season_number = $2
+			 ORDER BY number;`
+
+	rows, err := p.Conn.QueryContext(ctx, query, movieID, season)
+	if err != nil {
+		logrus.Errorf("Repo error: %v", err)
+		return nil, err
+	}
+
+	defer func() {
+		err := rows.Close()
+		if err != nil {
+			logrus.Errorf("Repo closing error: %v", err)
+		}
+	}()
+
+	result := make([]domain.Episode, 0)
+	for rows.Next() {
+		tmpEpisode := domain.Episode{}
+		err = rows.Scan(
+			&tmpEpisode.MovieID,
+			&tmpEpisode.SeasonNumber,
+			&tmpEpisode.Number,
+			&tmpEpisode.Name,
+			&tmpEpisode.EnName,
+			&tmpEpisode.AirDate,
+			&tmpEpisode.Description)
+
+		if err != nil {
+			logrus.Errorf("Repo error: %v", err)
+			return nil, err
+		}
+
+		result = append(result, tmpEpisode)
+	}
+
+	return result, err
+}
+
+func (p pgSeasonRepo) Save(ctx context.Context, s *domain.Season) error {
+	query := `INSERT into seasons(` + seasonColumns + `) VALUES ($1, $2, $3, $4, $5, $6, $7)
+			 ON CONFLICT (movie_id, number) DO UPDATE SET name = excluded.name, en_name = excluded.en_name,
+			 episodes_count = excluded.episodes_count, air_date = excluded.air_date,
+			 description = excluded.description;`
+
+	_, err := p.Conn.ExecContext(ctx, query, s.MovieID, s.Number, s.Name, s.EnName, s.EpisodesCount, s.AirDate,
+		s.Description)
+
+	return err
+}
+
+func (p pgSeasonRepo) SaveEpisode(ctx context.Context, e *domain.Episode) error {
+	query := `INSERT into episodes(` + episodeColumns + `) VALUES ($1, $2, $3, $4, $5, $6, $7)
+			 ON CONFLICT (movie_id, season_number, number) DO UPDATE SET name = excluded.name,
+			 en_name = excluded.en_name, air_date = excluded.air_date, description = excluded.description;`
+
+	_, err := p.Conn.ExecContext(ctx, query, e.MovieID, e.SeasonNumber, e.Number, e.Name, e.EnName, e.AirDate,
+		e.Description)
+
+	return err
+}
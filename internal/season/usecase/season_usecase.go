@@ -0,0 +1,81 @@
+package usecase
+
+import (
+	"Kinopoisk-Parser/internal/domain"
+	"context"
+	"fmt"
+	"github.com/sirupsen/logrus"
+	"time"
+)
+
+type seasonUsecase struct {
+	seasonRepo     domain.SeasonRepository
+	contextTimeout time.Duration
+}
+
+func NewSeasonUsecase(s domain.SeasonRepository, timeout time.Duration) domain.SeasonUsecase {
+	return &seasonUsecase{
+		seasonRepo:     s,
+		contextTimeout: timeout,
+	}
+}
+
+func (u *seasonUsecase) GetSeasons(ctx context.Context, movieID uint64) ([]domain.Season, error) {
+	ctx, cancel := context.WithTimeout(ctx, u.contextTimeout)
+	defer cancel()
+
+	seasons, err := u.seasonRepo.GetByMovie(ctx, movieID)
+	if err != nil {
+		logrus.Errorf("Usecase: %v", err)
+		return nil, fmt.Errorf("usecase: %v", err)
+	}
+
+	return seasons, nil
+}
+
+func (u *seasonUsecase) GetSeason(ctx context.Context, movieID, number uint64) (domain.Season, error) {
+	ctx, cancel := context.WithTimeout(ctx, u.contextTimeout)
+	defer cancel()
+
+	season, err := u.seasonRepo.GetByNumber(ctx, movieID, number)
+	if err == domain.SeasonNotFound {
+		return domain.Season{}, err
+	}
+	if err != nil {
+		logrus.Errorf("Usecase: %v", err)
+		return domain.Season{}, fmt.Errorf("usecase: %v", err)
+	}
+
+	season.Episodes, err = u.seasonRepo.GetEpisodes(ctx, movieID, number)
+	if err != nil {
+		logrus.Errorf("Usecase: %v", err)
+		return domain.Season{}, fmt.Errorf("usecase: %v", err)
+	}
+
+	return season, nil
+}
+
+// Save stores the seasons with their episodes, replacing the stored ones with
+// the same numbers.
+func (u *seasonUsecase) Save(ctx context.Context, seasons []domain.Season) error {
+	ctx, cancel := context.WithTimeout(ctx, u.contextTimeout)
+	defer cancel()
+
+	for i := range seasons {
+		err := u.seasonRepo.Save(ctx, &seasons[i])
+		if err != nil {
+			logrus.Errorf("Usecase: %v", err)
+			return fmt.Errorf("usecase: %v", err)
+		}
+
+		for j := range seasons[i].Episodes {
+			err = u.seasonRepo.SaveEpisode(ctx, &seasons[i].Episodes[j])
+			if err != nil {
+				logrus.Errorf("Usecase: %v", err)
+				return fmt.Errorf("usecase: %v", err)
+			}
+		}
+	}
+
+	return nil
+}
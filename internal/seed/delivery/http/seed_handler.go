@@ -0,0 +1,46 @@
+package http
+
+import (
+	"Kinopoisk-Parser/internal/domain"
+	"context"
+	"encoding/json"
+	"github.com/sirupsen/logrus"
+	"io"
+	"net/http"
+)
+
+type SeedHandler struct {
+	SUsecase domain.SeedUsecase
+}
+
+func NewSeedHandler(usecase domain.SeedUsecase) SeedHandler {
+	return SeedHandler{SUsecase: usecase}
+}
+
+func (h *SeedHandler) Add(w http.ResponseWriter, r *http.Request) {
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		logrus.Error("Bad request")
+		return
+	}
+
+	var seeds domain.Seeds
+
+	err = json.Unmarshal(body, &seeds)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		logrus.Error("Bad request while unmarshal")
+		return
+	}
+
+	added, err := h.SUsecase.Add(context.Background(), seeds)
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		logrus.Errorf("Error while add seeds: %v", err)
+		return
+	}
+
+	logrus.Infof("Added %d seeds to the frontier", added)
+	w.WriteHeader(http.StatusOK)
+}
@@ -0,0 +1,53 @@
+package http
+
+import (
+	"Kinopoisk-Parser/internal/domain"
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+type fakeSeeds struct {
+	seeds domain.Seeds
+	err   error
+}
+
+func (f *fakeSeeds) Add(ctx context.Context, seeds domain.Seeds) (uint64, error) {
+	f.seeds = seeds
+
+	return uint64(len(seeds.MovieIDs) + len(seeds.PersonIDs)), f.err
+}
+
+func post(handler SeedHandler, body string) int {
+	recorder := httptest.NewRecorder()
+	handler.Add(recorder, httptest.NewRequest("POST", "/crawl/seeds", strings.NewReader(body)))
+
+	return recorder.Code
+}
+
+func TestAdd(t *testing.T) {
+	usecase := &fakeSeeds{}
+
+	code := post(NewSeedHandler(usecase), `{"movie_ids": [301], "person_ids": [7836, 4587], "max_depth": 1}`)
+	if code != http.StatusOK {
+		t.Fatalf("status = %d", code)
+	}
+
+	seeds := usecase.seeds
+	if len(seeds.MovieIDs) != 1 || len(seeds.PersonIDs) != 2 || seeds.MaxDepth != 1 {
+		t.Errorf("seeds = %+v", seeds)
+	}
+}
+
+func TestAddErrors(t *testing.T) {
+	if code := post(NewSeedHandler(&fakeSeeds{}), `{"movie_ids": [`); code != http.StatusBadRequest {
+		t.Errorf("malformed body: status = %d", code)
+	}
+
+	if code := post(NewSeedHandler(&fakeSeeds{err: errors.New("connection refused")}), `{"movie_ids": [301]}`); code != http.StatusInternalServerError {
+		t.Errorf("usecase error: status = %d", code)
+	}
+}
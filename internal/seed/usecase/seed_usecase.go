@@ -0,0 +1,63 @@
+package usecase
+
+import (
+	"Kinopoisk-Parser/internal/domain"
+	"context"
+	"fmt"
+	"github.com/sirupsen/logrus"
+	"time"
+)
+
+type seedUsecase struct {
+	frontierRepo   domain.FrontierRepository
+	visitedRepo    domain.VisitedRepository
+	contextTimeout time.Duration
+}
+
+func NewSeedUsecase(f domain.FrontierRepository, v domain.VisitedRepository, timeout time.Duration) domain.SeedUsecase {
+	return &seedUsecase{
+		frontierRepo:   f,
+		visitedRepo:    v,
+		contextTimeout: timeout,
+	}
+}
+
+func (u *seedUsecase) Add(ctx context.Context, seeds domain.Seeds) (uint64, error) {
+	ctx, cancel := context.WithTimeout(ctx, u.contextTimeout)
+	defer cancel()
+
+	var added uint64
+
+	err := u.push(ctx, domain.MovieItem, seeds.MovieIDs, seeds.MaxDepth, &added)
+	if err != nil {
+		return added, err
+	}
+
+	err = u.push(ctx, domain.PersonItem, seeds.PersonIDs, seeds.MaxDepth, &added)
+	if err != nil {
+		return added, err
+	}
+
+	return added, nil
+}
+
+// push forgets the seeds before queueing them, so a seed that was crawled
+// before is expanded again instead of being skipped as visited.
+func (u *seedUsecase) push(ctx context.Context, kind uint64, ids []uint64, maxDepth uint64, added *uint64) error {
+	for _, id := range ids {
+		err := u.visitedRepo.Forget(ctx, kind, id)
+		if err != nil {
+			logrus.Errorf("Usecase: %v", err)
+			return fmt.Errorf("usecase: %v", err)
+		}
+
+		err = u.frontierRepo.Push(ctx, domain.FrontierItem{ID: id, Kind: kind, MaxDepth: maxDepth})
+		if err != nil {
+			logrus.Errorf("Usecase: %v", err)
+			return fmt.Errorf("usecase: %v", err)
+		}
+		*added += 1
+	}
+
+	return nil
+}
@@ -0,0 +1,65 @@
+package usecase
+
+import (
+	"Kinopoisk-Parser/internal/domain"
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+type fakeFrontier struct {
+	domain.FrontierRepository
+	pushed []domain.FrontierItem
+	err    error
+}
+
+func (f *fakeFrontier) Push(ctx context.Context, item domain.FrontierItem) error {
+	if f.err != nil {
+		return f.err
+	}
+	f.pushed = append(f.pushed, item)
+
+	return nil
+}
+
+type fakeVisited struct {
+	domain.VisitedRepository
+	forgotten []uint64
+}
+
+func (f *fakeVisited) Forget(ctx context.Context, kind, id uint64) error {
+	f.forgotten = append(f.forgotten, id)
+
+	return nil
+}
+
+func TestAdd(t *testing.T) {
+	frontier := &fakeFrontier{}
+	visited := &fakeVisited{}
+	u := NewSeedUsecase(frontier, visited, time.Second)
+
+	added, err := u.Add(context.Background(), domain.Seeds{MovieIDs: []uint64{301, 302}, PersonIDs: []uint64{7836}, MaxDepth: 2})
+	if err != nil {
+		t.Fatalf("add: %v", err)
+	}
+
+	if added != 3 || len(frontier.pushed) != 3 {
+		t.Fatalf("added = %d, pushed = %+v", added, frontier.pushed)
+	}
+	if person := frontier.pushed[2]; person.ID != 7836 || person.Kind != domain.PersonItem || person.MaxDepth != 2 {
+		t.Errorf("person seed = %+v", person)
+	}
+	if len(visited.forgotten) != 3 {
+		t.Errorf("forgotten = %v, visited seeds are expanded again", visited.forgotten)
+	}
+}
+
+func TestAddPushError(t *testing.T) {
+	u := NewSeedUsecase(&fakeFrontier{err: errors.New("connection refused")}, &fakeVisited{}, time.Second)
+
+	added, err := u.Add(context.Background(), domain.Seeds{MovieIDs: []uint64{301}})
+	if err == nil || added != 0 {
+		t.Errorf("added = %d, err = %v", added, err)
+	}
+}
@@ -2,7 +2,23 @@ package server
 
 import (
 	"Kinopoisk-Parser/config"
+	awardDelivery "Kinopoisk-Parser/internal/award/delivery/http"
+	neo4jAwardRepo "Kinopoisk-Parser/internal/award/repository/neo4j"
+	postgresqlAwardRepo "Kinopoisk-Parser/internal/award/repository/postgresql"
+	awardUsecase "Kinopoisk-Parser/internal/award/usecase"
+	boxofficeDelivery "Kinopoisk-Parser/internal/boxoffice/delivery/http"
+	neo4jExchangeRateRepo "Kinopoisk-Parser/internal/boxoffice/repository/neo4j"
+	postgresqlExchangeRateRepo "Kinopoisk-Parser/internal/boxoffice/repository/postgresql"
+	boxofficeUsecase "Kinopoisk-Parser/internal/boxoffice/usecase"
+	crawlDelivery "Kinopoisk-Parser/internal/crawl/delivery/http"
+	crawlUsecase "Kinopoisk-Parser/internal/crawl/usecase"
 	"Kinopoisk-Parser/internal/domain"
+	failedDelivery "Kinopoisk-Parser/internal/failed/delivery/http"
+	neo4jFailedRepo "Kinopoisk-Parser/internal/failed/repository/neo4j"
+	postgresqlFailedRepo "Kinopoisk-Parser/internal/failed/repository/postgresql"
+	failedUsecase "Kinopoisk-Parser/internal/failed/usecase"
+	neo4jFrontierRepo "Kinopoisk-Parser/internal/frontier/repository/neo4j"
+	postgresqlFrontierRepo "Kinopoisk-Parser/internal/frontier/repository/postgresql"
 	delivery "Kinopoisk-Parser/internal/movie/delivery/http"
 	neo4jMovieRepo "Kinopoisk-Parser/internal/movie/repository/neo4j"
 	postgresqlMovieRepo "Kinopoisk-Parser/internal/movie/repository/postgresql"
@@ -10,10 +26,43 @@ import (
 	movieParser "Kinopoisk-Parser/internal/parser"
 	neo4jPersonRepo "Kinopoisk-Parser/internal/person/repository/neo4j"
 	postgresPersonRepo "Kinopoisk-Parser/internal/person/repository/postgresql"
+	premiereDelivery "Kinopoisk-Parser/internal/premiere/delivery/http"
+	neo4jPremiereRepo "Kinopoisk-Parser/internal/premiere/repository/neo4j"
+	postgresqlPremiereRepo "Kinopoisk-Parser/internal/premiere/repository/postgresql"
+	premiereUsecase "Kinopoisk-Parser/internal/premiere/usecase"
 	neo4jProfessionRepo "Kinopoisk-Parser/internal/profession/repository/neo4j"
 	postgresqlProfessionRepo "Kinopoisk-Parser/internal/profession/repository/postgresql"
+	relationDelivery "Kinopoisk-Parser/internal/relation/delivery/http"
+	neo4jRelationRepo "Kinopoisk-Parser/internal/relation/repository/neo4j"
+	postgresqlRelationRepo "Kinopoisk-Parser/internal/relation/repository/postgresql"
+	relationUsecase "Kinopoisk-Parser/internal/relation/usecase"
+	reviewDelivery "Kinopoisk-Parser/internal/review/delivery/http"
+	neo4jReviewRepo "Kinopoisk-Parser/internal/review/repository/neo4j"
+	postgresqlReviewRepo "Kinopoisk-Parser/internal/review/repository/postgresql"
+	reviewUsecase "Kinopoisk-Parser/internal/review/usecase"
+	seasonDelivery "Kinopoisk-Parser/internal/season/delivery/http"
+	neo4jSeasonRepo "Kinopoisk-Parser/internal/season/repository/neo4j"
+	postgresqlSeasonRepo "Kinopoisk-Parser/internal/season/repository/postgresql"
+	seasonUsecase "Kinopoisk-Parser/internal/season/usecase"
+	seedDelivery "Kinopoisk-Parser/internal/seed/delivery/http"
+	seedUsecase "Kinopoisk-Parser/internal/seed/usecase"
+	"Kinopoisk-Parser/internal/source/archive"
+	fixtureSource "Kinopoisk-Parser/internal/source/fixture"
+	kinopoiskSource "Kinopoisk-Parser/internal/source/kinopoisk"
+	studioDelivery "Kinopoisk-Parser/internal/studio/delivery/http"
+	neo4jStudioRepo "Kinopoisk-Parser/internal/studio/repository/neo4j"
+	postgresqlStudioRepo "Kinopoisk-Parser/internal/studio/repository/postgresql"
+	studioUsecase "Kinopoisk-Parser/internal/studio/usecase"
+	tokenDelivery "Kinopoisk-Parser/internal/token/delivery/http"
+	tokenUsecase "Kinopoisk-Parser/internal/token/usecase"
+	neo4jVisitedRepo "Kinopoisk-Parser/internal/visited/repository/neo4j"
+	postgresqlVisitedRepo "Kinopoisk-Parser/internal/visited/repository/postgresql"
+	"context"
+	"encoding/json"
 	"github.com/gorilla/mux"
+	"github.com/sirupsen/logrus"
 	"net/http"
+	"os"
 	"time"
 )
 
@@ -32,30 +81,194 @@ func (s *Server) Start() error {
 		personRepo     domain.PersonRepository
 		movieRepo      domain.MovieRepository
 		professionRepo domain.ProfessionRepository
+		seasonRepo     domain.SeasonRepository
+		relationRepo   domain.RelationRepository
+		awardRepo      domain.AwardRepository
+		reviewRepo     domain.ReviewRepository
+		studioRepo     domain.StudioRepository
+		premiereRepo   domain.PremiereRepository
+		rateRepo       domain.ExchangeRateRepository
+		frontierRepo   domain.FrontierRepository
+		visitedRepo    domain.VisitedRepository
+		failedRepo     domain.FailedRepository
 	)
 
 	if s.config.DbParams.Scheme == "neo4j" {
 		db := domain.InitNeo4jConnectionByParams(s.config.DbParams)
+		if err := domain.InitNeo4jSchema(context.Background(), db); err != nil {
+			return err
+		}
 		personRepo = neo4jPersonRepo.New(db)
 		movieRepo = neo4jMovieRepo.New(db)
 		professionRepo = neo4jProfessionRepo.New(db)
+		seasonRepo = neo4jSeasonRepo.New(db)
+		relationRepo = neo4jRelationRepo.New(db)
+		awardRepo = neo4jAwardRepo.New(db)
+		reviewRepo = neo4jReviewRepo.New(db)
+		studioRepo = neo4jStudioRepo.New(db)
+		premiereRepo = neo4jPremiereRepo.New(db)
+		rateRepo = neo4jExchangeRateRepo.New(db)
+		frontierRepo = neo4jFrontierRepo.New(db)
+		visitedRepo = neo4jVisitedRepo.New(db)
+		failedRepo = neo4jFailedRepo.New(db)
 	} else {
 		db := domain.InitPgConnectionByParams(s.config.DbParams)
 		personRepo = postgresPersonRepo.New(db)
 		movieRepo = postgresqlMovieRepo.New(db)
 		professionRepo = postgresqlProfessionRepo.New(db)
+		seasonRepo = postgresqlSeasonRepo.New(db)
+		relationRepo = postgresqlRelationRepo.New(db)
+		awardRepo = postgresqlAwardRepo.New(db)
+		reviewRepo = postgresqlReviewRepo.New(db)
+		studioRepo = postgresqlStudioRepo.New(db)
+		premiereRepo = postgresqlPremiereRepo.New(db)
+		rateRepo = postgresqlExchangeRateRepo.New(db)
+		frontierRepo = postgresqlFrontierRepo.New(db)
+		visitedRepo = postgresqlVisitedRepo.New(db)
+		failedRepo = postgresqlFailedRepo.New(db)
+	}
+
+	err := professionRepo.SyncRoles(context.Background(), domain.Roles)
+	if err != nil {
+		return err
+	}
+
+	err = rateRepo.Seed(context.Background(), &domain.ExchangeRate{Currency: domain.BaseCurrency, Rate: 1, UpdatedAt: time.Now()})
+	if err != nil {
+		return err
 	}
 
 	movieUsecase := usecase.NewMovieUsecase(movieRepo, personRepo, professionRepo, 5*time.Second)
 	movieHandler := delivery.NewMovieHandler(movieUsecase)
 
-	parser := movieParser.NewParser(s.config.MaxMovies, s.config.TimeForSleep, s.config.MovieURL, s.config.PersonURL, s.config.Token, movieUsecase)
+	seasonsUsecase := seasonUsecase.NewSeasonUsecase(seasonRepo, 5*time.Second)
+	seasonHandler := seasonDelivery.NewSeasonHandler(seasonsUsecase)
+
+	relationsUsecase := relationUsecase.NewRelationUsecase(relationRepo, 5*time.Second)
+	relationHandler := relationDelivery.NewRelationHandler(relationsUsecase)
+
+	awardsUsecase := awardUsecase.NewAwardUsecase(awardRepo, 5*time.Second)
+	awardHandler := awardDelivery.NewAwardHandler(awardsUsecase)
+
+	reviewsUsecase := reviewUsecase.NewReviewUsecase(reviewRepo, 5*time.Second)
+	reviewHandler := reviewDelivery.NewReviewHandler(reviewsUsecase)
+
+	studiosUsecase := studioUsecase.NewStudioUsecase(studioRepo, 5*time.Second)
+	studioHandler := studioDelivery.NewStudioHandler(studiosUsecase)
+
+	premieresUsecase := premiereUsecase.NewPremiereUsecase(premiereRepo, 5*time.Second)
+	premiereHandler := premiereDelivery.NewPremiereHandler(premieresUsecase)
+
+	boxOfficeUsecase := boxofficeUsecase.NewBoxOfficeUsecase(movieRepo, rateRepo, 5*time.Second)
+	boxOfficeHandler := boxofficeDelivery.NewBoxOfficeHandler(boxOfficeUsecase)
+
+	failedItemsUsecase := failedUsecase.NewFailedUsecase(failedRepo, frontierRepo, 5*time.Second)
+	failedHandler := failedDelivery.NewFailedHandler(failedItemsUsecase)
+
+	seedsUsecase := seedUsecase.NewSeedUsecase(frontierRepo, visitedRepo, 5*time.Second)
+	seedHandler := seedDelivery.NewSeedHandler(seedsUsecase)
+
+	if s.config.Crawler.SeedFile != "" {
+		err = loadSeedFile(s.config.Crawler.SeedFile, seedsUsecase)
+		if err != nil {
+			return err
+		}
+	}
+
+	tokens := tokenUsecase.NewTokenPool(s.config.APITokens(), frontierRepo)
+	tokenHandler := tokenDelivery.NewTokenHandler(tokens)
+
+	gate := movieParser.NewRequestGate(s.config.Crawler.RequestsPerSecond, s.config.Crawler.Burst,
+		s.config.TimeForSleep, s.config.Crawler.DailyRequestLimit, frontierRepo)
+
+	var source domain.MovieSource
+	if s.config.Crawler.Source == "fixture" {
+		source = fixtureSource.New(s.config.Crawler.FixtureDir)
+	} else {
+		source = s.createKinopoiskSource(tokens, gate, frontierRepo)
+	}
 
-	go parser.Parse()
+	parser := movieParser.NewParser(s.config.MaxMovies, s.config.TimeForSleep, s.config.Crawler, source,
+		movieUsecase, seasonsUsecase, relationsUsecase, awardsUsecase, reviewsUsecase,
+		studiosUsecase, premieresUsecase, frontierRepo, visitedRepo, failedRepo)
+
+	crawl := crawlUsecase.NewCrawlUsecase(parser, gate, frontierRepo, 5*time.Second)
+	crawlHandler := crawlDelivery.NewCrawlHandler(crawl)
+
+	err = crawl.Start(context.Background())
+	if err != nil {
+		return err
+	}
 
 	r.HandleFunc("/add", movieHandler.Add).Methods("POST")
 	r.HandleFunc("/movies/{movies-title}", movieHandler.GetMovie).Methods("GET")
 	r.HandleFunc("/movies", movieHandler.GetMovies).Methods("GET")
+	r.HandleFunc("/movies/{id:[0-9]+}/seasons", seasonHandler.GetSeasons).Methods("GET")
+	r.HandleFunc("/movies/{id:[0-9]+}/seasons/{number:[0-9]+}", seasonHandler.GetSeason).Methods("GET")
+	r.HandleFunc("/movies/{id:[0-9]+}/related", relationHandler.GetRelated).Methods("GET")
+	r.HandleFunc("/movies/{id:[0-9]+}/awards", awardHandler.GetMovieAwards).Methods("GET")
+	r.HandleFunc("/persons/{id:[0-9]+}/awards", awardHandler.GetPersonAwards).Methods("GET")
+	r.HandleFunc("/awards", awardHandler.Find).Methods("GET")
+	r.HandleFunc("/movies/{id:[0-9]+}/reviews", reviewHandler.GetReviews).Methods("GET")
+	r.HandleFunc("/movies/{id:[0-9]+}/studios", studioHandler.GetMovieStudios).Methods("GET")
+	r.HandleFunc("/studios/{id:[0-9]+}", studioHandler.GetStudio).Methods("GET")
+	r.HandleFunc("/movies/{id:[0-9]+}/premieres", premiereHandler.GetMoviePremieres).Methods("GET")
+	r.HandleFunc("/premieres", premiereHandler.Find).Methods("GET")
+	r.HandleFunc("/movies/{id:[0-9]+}/box-office", boxOfficeHandler.GetBoxOffice).Methods("GET")
+	r.HandleFunc("/exchange-rates", boxOfficeHandler.GetRates).Methods("GET")
+	r.HandleFunc("/exchange-rates", boxOfficeHandler.SetRate).Methods("PUT")
+	r.HandleFunc("/failed", failedHandler.GetFailed).Methods("GET")
+	r.HandleFunc("/tokens", tokenHandler.GetUsage).Methods("GET")
+	r.HandleFunc("/crawl/seeds", seedHandler.Add).Methods("POST")
+	r.HandleFunc("/crawl/start", crawlHandler.Start).Methods("POST")
+	r.HandleFunc("/crawl/pause", crawlHandler.Pause).Methods("POST")
+	r.HandleFunc("/crawl/resume", crawlHandler.Resume).Methods("POST")
+	r.HandleFunc("/crawl/stop", crawlHandler.Stop).Methods("POST")
+	r.HandleFunc("/crawl/rate", crawlHandler.SetRate).Methods("PUT")
+	r.HandleFunc("/crawl/status", crawlHandler.GetStatus).Methods("GET")
 
 	return http.ListenAndServe(s.config.StartPort, r)
 }
+
+func (s *Server) createKinopoiskSource(tokens domain.TokenPool, gate domain.RequestGate,
+	frontierRepo domain.FrontierRepository) domain.MovieSource {
+	params := s.config.Crawler
+	client := &http.Client{}
+
+	switch params.ArchiveMode {
+	case archive.RecordMode:
+		client.Transport = archive.NewRecorder(params.ArchiveDir, http.DefaultTransport)
+	case archive.ReplayMode:
+		client.Transport = archive.NewReplayer(params.ArchiveDir)
+		replayGate := movieParser.NewRequestGate(0, 0, 0, 0, frontierRepo)
+		replayTokens := tokenUsecase.NewTokenPool([]config.TokenParams{{Key: archive.ReplayMode}}, frontierRepo)
+		return kinopoiskSource.New(s.config.MovieURL, s.config.PersonURL, s.config.SeasonURL,
+			s.config.ReviewURL, s.config.StudioURL, replayTokens, params, replayGate, client)
+	}
+
+	return kinopoiskSource.New(s.config.MovieURL, s.config.PersonURL, s.config.SeasonURL, s.config.ReviewURL,
+		s.config.StudioURL, tokens, params, gate, client)
+}
+
+func loadSeedFile(path string, seeds domain.SeedUsecase) error {
+	body, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+
+	var seedList domain.Seeds
+
+	err = json.Unmarshal(body, &seedList)
+	if err != nil {
+		return err
+	}
+
+	added, err := seeds.Add(context.Background(), seedList)
+	if err != nil {
+		return err
+	}
+
+	logrus.Infof("Added %d seeds from %s", added, path)
+
+	return nil
+}
@@ -0,0 +1,143 @@
+package archive
+
+import (
+	"bytes"
+	"crypto/sha1"
+	"encoding/hex"
+	"encoding/json"
+	"github.com/sirupsen/logrus"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strconv"
+)
+
+const (
+	RecordMode = "record"
+	ReplayMode = "replay"
+)
+
+type Entry struct {
+	URL     string      `json:"url"`
+	Status  int         `json:"status"`
+	Headers http.Header `json:"headers"`
+	Body    string      `json:"body"`
+}
+
+type Recorder struct {
+	Dir  string
+	Next http.RoundTripper
+}
+
+type Replayer struct {
+	Dir string
+}
+
+func NewRecorder(dir string, next http.RoundTripper) *Recorder {
+	return &Recorder{Dir: dir, Next: next}
+}
+
+func NewReplayer(dir string) *Replayer {
+	return &Replayer{Dir: dir}
+}
+
+func (r *Recorder) RoundTrip(req *http.Request) (*http.Response, error) {
+	resp, err := r.Next.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	closeErr := resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	if closeErr != nil {
+		logrus.Errorf("Archive closing error: %v", closeErr)
+	}
+
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+
+	entry := Entry{
+		URL:     req.URL.String(),
+		Status:  resp.StatusCode,
+		Headers: resp.Header,
+		Body:    string(body),
+	}
+
+	err = r.write(entry)
+	if err != nil {
+		logrus.Errorf("Archive error write %s: %v", entry.URL, err)
+	}
+
+	return resp, nil
+}
+
+func (r *Recorder) write(entry Entry) error {
+	err := os.MkdirAll(r.Dir, 0755)
+	if err != nil {
+		return err
+	}
+
+	raw, err := json.Marshal(entry)
+	if err != nil {
+		return err
+	}
+
+	path := entryPath(r.Dir, entry.URL)
+	tmp, err := os.CreateTemp(r.Dir, "entry-*.tmp")
+	if err != nil {
+		return err
+	}
+
+	_, err = tmp.Write(raw)
+	closeErr := tmp.Close()
+	if err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		os.Remove(tmp.Name())
+		return err
+	}
+
+	return os.Rename(tmp.Name(), path)
+}
+
+func (r *Replayer) RoundTrip(req *http.Request) (*http.Response, error) {
+	raw, err := os.ReadFile(entryPath(r.Dir, req.URL.String()))
+	if os.IsNotExist(err) {
+		logrus.Warnf("Archive has no entry for %s", req.URL.String())
+		return response(req, Entry{Status: http.StatusNotFound, Headers: http.Header{}}), nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var entry Entry
+	err = json.Unmarshal(raw, &entry)
+	if err != nil {
+		return nil, err
+	}
+
+	return response(req, entry), nil
+}
+
+func response(req *http.Request, entry Entry) *http.Response {
+	return &http.Response{
+		Status:        strconv.Itoa(entry.Status) + " " + http.StatusText(entry.Status),
+		StatusCode:    entry.Status,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        entry.Headers,
+		Body:          io.NopCloser(bytes.NewReader([]byte(entry.Body))),
+		ContentLength: int64(len(entry.Body)),
+		Request:       req,
+	}
+}
+
+func entryPath(dir, URL string) string {
+	hash := sha1.Sum([]byte(URL))
+	return filepath.Join(dir, hex.EncodeToString(hash[:])+".json")
+}
@@ -0,0 +1,105 @@
+package fixture
+
+import (
+	"Kinopoisk-Parser/internal/domain"
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/sirupsen/logrus"
+	"os"
+	"path/filepath"
+)
+
+// FixtureSource reads kinopoisk responses from JSON files. The details of a
+// movie or person, like seasons or awards, are read from one file, so the page
+// is ignored and the file is expected to report a single page.
+type FixtureSource struct {
+	Dir string
+}
+
+func New(dir string) domain.MovieSource {
+	return &FixtureSource{Dir: dir}
+}
+
+func (s *FixtureSource) GetMovie(ctx context.Context, id uint64) (domain.MovieDTO, error) {
+	var movie domain.MovieDTO
+
+	err := s.read(filepath.Join(s.Dir, "movie", fmt.Sprintf("%d.json", id)), &movie)
+
+	return movie, err
+}
+
+func (s *FixtureSource) GetPerson(ctx context.Context, id uint64) (domain.PersonDTO, error) {
+	var person domain.PersonDTO
+
+	err := s.read(filepath.Join(s.Dir, "person", fmt.Sprintf("%d.json", id)), &person)
+
+	return person, err
+}
+
+func (s *FixtureSource) GetMovies(ctx context.Context, page, limit uint64, filter domain.MovieFilter) (domain.MoviePageDTO, error) {
+	var movies domain.MoviePageDTO
+
+	err := s.read(filepath.Join(s.Dir, "movies", fmt.Sprintf("%d.json", page)), &movies)
+
+	return movies, err
+}
+
+func (s *FixtureSource) GetSeasons(ctx context.Context, movieID, page, limit uint64) (domain.SeasonPageDTO, error) {
+	var seasons domain.SeasonPageDTO
+
+	err := s.read(filepath.Join(s.Dir, "season", fmt.Sprintf("%d.json", movieID)), &seasons)
+
+	return seasons, err
+}
+
+func (s *FixtureSource) GetMovieAwards(ctx context.Context, movieID, page, limit uint64) (domain.AwardPageDTO, error) {
+	var awards domain.AwardPageDTO
+
+	err := s.read(filepath.Join(s.Dir, "movie_awards", fmt.Sprintf("%d.json", movieID)), &awards)
+
+	return awards, err
+}
+
+func (s *FixtureSource) GetPersonAwards(ctx context.Context, personID, page, limit uint64) (domain.AwardPageDTO, error) {
+	var awards domain.AwardPageDTO
+
+	err := s.read(filepath.Join(s.Dir, "person_awards", fmt.Sprintf("%d.json", personID)), &awards)
+
+	return awards, err
+}
+
+func (s *FixtureSource) GetReviews(ctx context.Context, movieID, page, limit uint64) (domain.ReviewPageDTO, error) {
+	var reviews domain.ReviewPageDTO
+
+	err := s.read(filepath.Join(s.Dir, "review", fmt.Sprintf("%d.json", movieID)), &reviews)
+
+	return reviews, err
+}
+
+func (s *FixtureSource) GetStudios(ctx context.Context, movieID, page, limit uint64) (domain.StudioPageDTO, error) {
+	var studios domain.StudioPageDTO
+
+	err := s.read(filepath.Join(s.Dir, "studio", fmt.Sprintf("%d.json", movieID)), &studios)
+
+	return studios, err
+}
+
+func (s *FixtureSource) read(path string, result any) error {
+	body, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return domain.UpstreamNotFound
+	}
+	if err != nil {
+		logrus.Errorf("Source error read %s: %v", path, err)
+		return err
+	}
+
+	err = json.Unmarshal(body, result)
+	if err != nil {
+		logrus.Errorf("Source error unmarshal %s: %v", path, err)
+		return err
+	}
+
+	return nil
+}
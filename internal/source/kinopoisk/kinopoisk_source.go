@@ -0,0 +1,201 @@
+package kinopoisk
+
+import (
+	"Kinopoisk-Parser/config"
+	"Kinopoisk-Parser/internal/domain"
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/sirupsen/logrus"
+	"net/http"
+	"net/url"
+	"strconv"
+)
+
+const (
+	minYear = 1874
+	maxYear = 2100
+
+	maxLength = 10000
+)
+
+type KinopoiskSource struct {
+	MovieURL  string
+	PersonURL string
+	SeasonURL string
+	ReviewURL string
+	StudioURL string
+	Tokens    domain.TokenPool
+	Retry     RetryPolicy
+	Gate      domain.RequestGate
+	Client    *http.Client
+}
+
+func New(movieURL, personURL, seasonURL, reviewURL, studioURL string, tokens domain.TokenPool, params config.CrawlerParams,
+	gate domain.RequestGate, client *http.Client) domain.MovieSource {
+	return &KinopoiskSource{
+		MovieURL:  movieURL,
+		PersonURL: personURL,
+		SeasonURL: seasonURL,
+		ReviewURL: reviewURL,
+		StudioURL: studioURL,
+		Tokens:    tokens,
+		Retry:     NewRetryPolicy(params.MaxAttempts, params.BackoffBaseMs, params.BackoffMaxMs),
+		Gate:      gate,
+		Client:    client,
+	}
+}
+
+func (s *KinopoiskSource) GetMovie(ctx context.Context, id uint64) (domain.MovieDTO, error) {
+	var movie domain.MovieDTO
+
+	err := s.get(ctx, fmt.Sprintf("%s/%d", s.MovieURL, id), &movie)
+
+	return movie, err
+}
+
+func (s *KinopoiskSource) GetPerson(ctx context.Context, id uint64) (domain.PersonDTO, error) {
+	var person domain.PersonDTO
+
+	err := s.get(ctx, fmt.Sprintf("%s/%d", s.PersonURL, id), &person)
+
+	return person, err
+}
+
+func (s *KinopoiskSource) GetMovies(ctx context.Context, page, limit uint64, filter domain.MovieFilter) (domain.MoviePageDTO, error) {
+	var movies domain.MoviePageDTO
+
+	err := s.get(ctx, fmt.Sprintf("%s?%s", s.MovieURL, pageQuery(page, limit, filter).Encode()), &movies)
+
+	return movies, err
+}
+
+func (s *KinopoiskSource) GetSeasons(ctx context.Context, movieID, page, limit uint64) (domain.SeasonPageDTO, error) {
+	var seasons domain.SeasonPageDTO
+
+	query := url.Values{}
+	query.Set("movieId", strconv.FormatUint(movieID, 10))
+	query.Set("page", strconv.FormatUint(page, 10))
+	query.Set("limit", strconv.FormatUint(limit, 10))
+	query.Set("sortField", "number")
+	query.Set("sortType", "1")
+
+	err := s.get(ctx, fmt.Sprintf("%s?%s", s.SeasonURL, query.Encode()), &seasons)
+
+	return seasons, err
+}
+
+func (s *KinopoiskSource) GetMovieAwards(ctx context.Context, movieID, page, limit uint64) (domain.AwardPageDTO, error) {
+	var awards domain.AwardPageDTO
+
+	query := idPageQuery("movieId", movieID, page, limit)
+	err := s.get(ctx, fmt.Sprintf("%s/awards?%s", s.MovieURL, query.Encode()), &awards)
+
+	return awards, err
+}
+
+func (s *KinopoiskSource) GetPersonAwards(ctx context.Context, personID, page, limit uint64) (domain.AwardPageDTO, error) {
+	var awards domain.AwardPageDTO
+
+	query := idPageQuery("personId", personID, page, limit)
+	err := s.get(ctx, fmt.Sprintf("%s/awards?%s", s.PersonURL, query.Encode()), &awards)
+
+	return awards, err
+}
+
+func (s *KinopoiskSource) GetReviews(ctx context.Context, movieID, page, limit uint64) (domain.ReviewPageDTO, error) {
+	var reviews domain.ReviewPageDTO
+
+	query := idPageQuery("movieId", movieID, page, limit)
+	err := s.get(ctx, fmt.Sprintf("%s?%s", s.ReviewURL, query.Encode()), &reviews)
+
+	return reviews, err
+}
+
+func (s *KinopoiskSource) GetStudios(ctx context.Context, movieID, page, limit uint64) (domain.StudioPageDTO, error) {
+	var studios domain.StudioPageDTO
+
+	query := idPageQuery("movies.id", movieID, page, limit)
+	query.Add("selectFields", "id")
+	query.Add("selectFields", "title")
+	query.Add("selectFields", "type")
+	query.Add("selectFields", "subType")
+	err := s.get(ctx, fmt.Sprintf("%s?%s", s.StudioURL, query.Encode()), &studios)
+
+	return studios, err
+}
+
+func idPageQuery(key string, id, page, limit uint64) url.Values {
+	query := url.Values{}
+	query.Set(key, strconv.FormatUint(id, 10))
+	query.Set("page", strconv.FormatUint(page, 10))
+	query.Set("limit", strconv.FormatUint(limit, 10))
+
+	return query
+}
+
+func pageQuery(page, limit uint64, filter domain.MovieFilter) url.Values {
+	query := url.Values{}
+	query.Set("page", strconv.FormatUint(page, 10))
+	query.Set("limit", strconv.FormatUint(limit, 10))
+
+	switch {
+	case filter.YearFrom > 0 && filter.YearTo > 0:
+		query.Set("year", fmt.Sprintf("%d-%d", filter.YearFrom, filter.YearTo))
+	case filter.YearFrom > 0:
+		query.Set("year", fmt.Sprintf("%d-%d", filter.YearFrom, maxYear))
+	case filter.YearTo > 0:
+		query.Set("year", fmt.Sprintf("%d-%d", minYear, filter.YearTo))
+	}
+
+	for _, movieType := range filter.Types {
+		query.Add("type", movieType)
+	}
+
+	for _, country := range filter.Countries {
+		query.Add("countries.name", country)
+	}
+
+	if filter.MinLength > 0 {
+		query.Set("movieLength", fmt.Sprintf("%d-%d", filter.MinLength, maxLength))
+	}
+
+	if filter.MinRating > 0 {
+		query.Set("rating.imdb", fmt.Sprintf("%g-10", filter.MinRating))
+	}
+
+	return query
+}
+
+func (s *KinopoiskSource) get(ctx context.Context, URL string, result any) error {
+	body, err := s.fetch(ctx, URL)
+	if err != nil {
+		logrus.Errorf("Source error fetch %s: %v", URL, err)
+		return err
+	}
+
+	err = json.Unmarshal(body, result)
+	if err != nil {
+		logrus.Errorf("Source error unmarshal %s: %v", URL, err)
+		return err
+	}
+
+	return nil
+}
+
+func (s *KinopoiskSource) sendRequest(req *http.Request) (*http.Response, string, error) {
+	err := s.Gate.Wait(req.Context())
+	if err != nil {
+		return nil, "", err
+	}
+
+	token, err := s.Tokens.Acquire(req.Context())
+	if err != nil {
+		return nil, "", err
+	}
+
+	req.Header.Add("X-API-KEY", token)
+	resp, err := s.Client.Do(req)
+
+	return resp, token, err
+}
@@ -0,0 +1,185 @@
+package kinopoisk
+
+import (
+	"Kinopoisk-Parser/internal/domain"
+	"context"
+	"github.com/sirupsen/logrus"
+	"io"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+const (
+	defaultMaxAttempts = 5
+	defaultBackoffBase = 500 * time.Millisecond
+	defaultBackoffMax  = 30 * time.Second
+)
+
+type RetryPolicy struct {
+	MaxAttempts uint64
+	BackoffBase time.Duration
+	BackoffMax  time.Duration
+}
+
+func NewRetryPolicy(maxAttempts, backoffBaseMs, backoffMaxMs uint64) RetryPolicy {
+	policy := RetryPolicy{
+		MaxAttempts: maxAttempts,
+		BackoffBase: time.Millisecond * time.Duration(backoffBaseMs),
+		BackoffMax:  time.Millisecond * time.Duration(backoffMaxMs),
+	}
+
+	if policy.MaxAttempts == 0 {
+		policy.MaxAttempts = defaultMaxAttempts
+	}
+	if policy.BackoffBase == 0 {
+		policy.BackoffBase = defaultBackoffBase
+	}
+	if policy.BackoffMax == 0 {
+		policy.BackoffMax = defaultBackoffMax
+	}
+
+	return policy
+}
+
+func (r RetryPolicy) backoff(attempt uint64) time.Duration {
+	delay := r.BackoffBase
+	for i := uint64(1); i < attempt && delay < r.BackoffMax; i++ {
+		delay *= 2
+	}
+
+	if delay > r.BackoffMax {
+		delay = r.BackoffMax
+	}
+
+	half := delay / 2
+	return half + time.Duration(rand.Int63n(int64(half)+1))
+}
+
+func classifyStatus(statusCode int) error {
+	switch {
+	case statusCode == http.StatusOK:
+		return nil
+	case statusCode == http.StatusNotFound:
+		return domain.UpstreamNotFound
+	case statusCode == http.StatusUnauthorized || statusCode == http.StatusForbidden:
+		return domain.UpstreamUnauthorized
+	case statusCode == http.StatusTooManyRequests:
+		return domain.UpstreamRateLimited
+	case statusCode >= http.StatusInternalServerError:
+		return domain.UpstreamServerError
+	default:
+		return domain.UpstreamBadStatus
+	}
+}
+
+func retryable(err error) bool {
+	switch err {
+	case domain.UpstreamNotFound, domain.UpstreamBadStatus, domain.RequestBudgetExhausted,
+		domain.TokensExhausted, context.Canceled, context.DeadlineExceeded:
+		return false
+	default:
+		return true
+	}
+}
+
+// retryAfter returns the delay asked for by the Retry-After header, 0 when
+// there is none or it is already over, so the backoff is used instead.
+func retryAfter(resp *http.Response) time.Duration {
+	value := resp.Header.Get("Retry-After")
+	if value == "" {
+		return 0
+	}
+
+	var delay time.Duration
+	seconds, err := strconv.Atoi(value)
+	if err == nil {
+		delay = time.Second * time.Duration(seconds)
+	} else if date, err := http.ParseTime(value); err == nil {
+		delay = time.Until(date)
+	}
+
+	if delay < 0 {
+		return 0
+	}
+
+	return delay
+}
+
+func (s *KinopoiskSource) fetch(ctx context.Context, URL string) ([]byte, error) {
+	var err error
+	for attempt := uint64(1); attempt <= s.Retry.MaxAttempts; attempt++ {
+		var (
+			body []byte
+			wait time.Duration
+		)
+
+		body, wait, err = s.fetchOnce(ctx, URL)
+		if err == nil {
+			return body, nil
+		}
+
+		if err == domain.UpstreamUnauthorized && ctx.Err() == nil {
+			attempt--
+			continue
+		}
+
+		if !retryable(err) || ctx.Err() != nil || attempt == s.Retry.MaxAttempts {
+			break
+		}
+
+		if wait == 0 {
+			wait = s.Retry.backoff(attempt)
+		}
+
+		logrus.Warnf("Source retry %s after %v (attempt %d/%d): %v", URL, wait, attempt, s.Retry.MaxAttempts, err)
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(wait):
+		}
+	}
+
+	return nil, err
+}
+
+func (s *KinopoiskSource) fetchOnce(ctx context.Context, URL string) ([]byte, time.Duration, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", URL, nil)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	resp, token, err := s.sendRequest(req)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	defer func() {
+		err := resp.Body.Close()
+		if err != nil {
+			logrus.Errorf("Source closing error: %v", err)
+		}
+	}()
+
+	err = classifyStatus(resp.StatusCode)
+	if err == domain.UpstreamUnauthorized {
+		logrus.Warnf("Request %s status code = %d, rotating token", URL, resp.StatusCode)
+		exhaustErr := s.Tokens.Exhaust(ctx, token)
+		if exhaustErr != nil {
+			return nil, 0, exhaustErr
+		}
+	}
+	if err != nil {
+		logrus.Errorf("Request %s status code = %d", URL, resp.StatusCode)
+		return nil, retryAfter(resp), err
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return body, 0, nil
+}
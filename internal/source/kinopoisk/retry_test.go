@@ -0,0 +1,244 @@
+package kinopoisk
+
+import (
+	"Kinopoisk-Parser/internal/domain"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+)
+
+type fakeGate struct{}
+
+func (fakeGate) Wait(ctx context.Context) error {
+	return nil
+}
+
+// fakeTokens hands out the tokens in order, an exhausted token is replaced by
+// the next one.
+type fakeTokens struct {
+	domain.TokenPool
+	mutex     sync.Mutex
+	tokens    []string
+	exhausted []string
+}
+
+func (f *fakeTokens) Acquire(ctx context.Context) (string, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.tokens) == 0 {
+		return "", domain.TokensExhausted
+	}
+
+	return f.tokens[0], nil
+}
+
+func (f *fakeTokens) Exhaust(ctx context.Context, token string) error {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	f.exhausted = append(f.exhausted, token)
+	if len(f.tokens) > 0 && f.tokens[0] == token {
+		f.tokens = f.tokens[1:]
+	}
+
+	return nil
+}
+
+// newTestSource serves the responses in order, the last one is repeated.
+func newTestSource(t *testing.T, tokens *fakeTokens, responses ...int) (*KinopoiskSource, *[]string) {
+	var (
+		mutex    sync.Mutex
+		requests []string
+	)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mutex.Lock()
+		defer mutex.Unlock()
+
+		status := responses[len(responses)-1]
+		if len(requests) < len(responses) {
+			status = responses[len(requests)]
+		}
+		requests = append(requests, r.Header.Get("X-API-KEY"))
+
+		w.WriteHeader(status)
+		_, _ = w.Write([]byte(`{"id": 301}`))
+	}))
+	t.Cleanup(server.Close)
+
+	source := &KinopoiskSource{
+		MovieURL: server.URL,
+		Tokens:   tokens,
+		Retry:    NewRetryPolicy(3, 1, 2),
+		Gate:     fakeGate{},
+		Client:   server.Client(),
+	}
+
+	return source, &requests
+}
+
+func TestNewRetryPolicyDefaults(t *testing.T) {
+	policy := NewRetryPolicy(0, 0, 0)
+
+	if policy.MaxAttempts != defaultMaxAttempts || policy.BackoffBase != defaultBackoffBase ||
+		policy.BackoffMax != defaultBackoffMax {
+		t.Errorf("policy = %+v", policy)
+	}
+}
+
+func TestBackoff(t *testing.T) {
+	policy := NewRetryPolicy(10, 100, 1000)
+
+	tests := []struct {
+		attempt uint64
+		max     time.Duration
+	}{
+		{1, 100 * time.Millisecond},
+		{2, 200 * time.Millisecond},
+		{3, 400 * time.Millisecond},
+		{5, time.Second},
+		{10, time.Second},
+	}
+
+	for _, test := range tests {
+		for i := 0; i < 20; i++ {
+			delay := policy.backoff(test.attempt)
+			if delay < test.max/2 || delay > test.max {
+				t.Fatalf("attempt %d: backoff = %v, expected between %v and %v",
+					test.attempt, delay, test.max/2, test.max)
+			}
+		}
+	}
+}
+
+func TestClassifyStatus(t *testing.T) {
+	tests := map[int]error{
+		http.StatusOK:                  nil,
+		http.StatusNotFound:            domain.UpstreamNotFound,
+		http.StatusUnauthorized:        domain.UpstreamUnauthorized,
+		http.StatusForbidden:           domain.UpstreamUnauthorized,
+		http.StatusTooManyRequests:     domain.UpstreamRateLimited,
+		http.StatusBadGateway:          domain.UpstreamServerError,
+		http.StatusBadRequest:          domain.UpstreamBadStatus,
+		http.StatusInternalServerError: domain.UpstreamServerError,
+	}
+
+	for status, expected := range tests {
+		if err := classifyStatus(status); err != expected {
+			t.Errorf("status %d: err = %v, expected %v", status, err, expected)
+		}
+	}
+}
+
+func TestRetryable(t *testing.T) {
+	for _, err := range []error{domain.UpstreamRateLimited, domain.UpstreamServerError, domain.UpstreamUnauthorized} {
+		if !retryable(err) {
+			t.Errorf("%v is not retried", err)
+		}
+	}
+
+	for _, err := range []error{domain.UpstreamNotFound, domain.UpstreamBadStatus, domain.RequestBudgetExhausted,
+		domain.TokensExhausted, context.Canceled} {
+		if retryable(err) {
+			t.Errorf("%v is retried", err)
+		}
+	}
+}
+
+func TestRetryAfter(t *testing.T) {
+	tests := []struct {
+		name  string
+		value string
+		min   time.Duration
+		max   time.Duration
+	}{
+		{"none", "", 0, 0},
+		{"seconds", "3", 3 * time.Second, 3 * time.Second},
+		{"future date", time.Now().Add(time.Minute).UTC().Format(http.TimeFormat), 58 * time.Second, time.Minute},
+		{"past date", time.Now().Add(-time.Minute).UTC().Format(http.TimeFormat), 0, 0},
+		{"garbage", "soon", 0, 0},
+	}
+
+	for _, test := range tests {
+		resp := &http.Response{Header: http.Header{}}
+		if test.value != "" {
+			resp.Header.Set("Retry-After", test.value)
+		}
+
+		delay := retryAfter(resp)
+		if delay < test.min || delay > test.max {
+			t.Errorf("%s: retry after = %v", test.name, delay)
+		}
+	}
+}
+
+func TestFetchRetriesServerErrors(t *testing.T) {
+	source, requests := newTestSource(t, &fakeTokens{tokens: []string{"a"}},
+		http.StatusServiceUnavailable, http.StatusTooManyRequests, http.StatusOK)
+
+	movie, err := source.GetMovie(context.Background(), 301)
+	if err != nil {
+		t.Fatalf("get movie: %v", err)
+	}
+	if movie.Id != 301 || len(*requests) != 3 {
+		t.Errorf("movie id = %d, requests = %d", movie.Id, len(*requests))
+	}
+}
+
+func TestFetchGivesUpAfterMaxAttempts(t *testing.T) {
+	source, requests := newTestSource(t, &fakeTokens{tokens: []string{"a"}}, http.StatusInternalServerError)
+
+	_, err := source.GetMovie(context.Background(), 301)
+	if err != domain.UpstreamServerError {
+		t.Errorf("err = %v", err)
+	}
+	if len(*requests) != 3 {
+		t.Errorf("requests = %d, expected max attempts", len(*requests))
+	}
+}
+
+func TestFetchNotFound(t *testing.T) {
+	source, requests := newTestSource(t, &fakeTokens{tokens: []string{"a"}}, http.StatusNotFound)
+
+	_, err := source.GetMovie(context.Background(), 301)
+	if err != domain.UpstreamNotFound {
+		t.Errorf("err = %v", err)
+	}
+	if len(*requests) != 1 {
+		t.Errorf("requests = %d, a missing item is not retried", len(*requests))
+	}
+}
+
+func TestFetchRotatesUnauthorizedToken(t *testing.T) {
+	tokens := &fakeTokens{tokens: []string{"a", "b", "c"}}
+	source, requests := newTestSource(t, tokens,
+		http.StatusUnauthorized, http.StatusForbidden, http.StatusServiceUnavailable, http.StatusOK)
+
+	_, err := source.GetMovie(context.Background(), 301)
+	if err != nil {
+		t.Fatalf("get movie: %v", err)
+	}
+
+	if len(tokens.exhausted) != 2 || tokens.exhausted[0] != "a" || tokens.exhausted[1] != "b" {
+		t.Errorf("exhausted tokens = %v", tokens.exhausted)
+	}
+	if len(*requests) != 4 || (*requests)[3] != "c" {
+		t.Errorf("requests = %v, token rotations do not use up attempts", *requests)
+	}
+}
+
+func TestFetchTokensExhausted(t *testing.T) {
+	source, requests := newTestSource(t, &fakeTokens{tokens: []string{"a"}}, http.StatusUnauthorized)
+
+	_, err := source.GetMovie(context.Background(), 301)
+	if err != domain.TokensExhausted {
+		t.Errorf("err = %v", err)
+	}
+	if len(*requests) != 1 {
+		t.Errorf("requests = %d", len(*requests))
+	}
+}
@@ -0,0 +1,83 @@
+package http
+
+import (
+	"Kinopoisk-Parser/internal/domain"
+	"context"
+	"encoding/json"
+	"github.com/gorilla/mux"
+	"github.com/sirupsen/logrus"
+	"net/http"
+	"strconv"
+)
+
+type StudioHandler struct {
+	SUsecase domain.StudioUsecase
+}
+
+func NewStudioHandler(usecase domain.StudioUsecase) StudioHandler {
+	return StudioHandler{SUsecase: usecase}
+}
+
+func (h *StudioHandler) GetMovieStudios(w http.ResponseWriter, r *http.Request) {
+	movieID, err := strconv.ParseUint(mux.Vars(r)["id"], 10, 64)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		logrus.Error("Bad request: movie id")
+		return
+	}
+
+	studios, err := h.SUsecase.GetByMovie(context.Background(), movieID, r.URL.Query().Get("type"))
+	switch err {
+	case domain.UnknownStudioType:
+		w.WriteHeader(http.StatusBadRequest)
+		logrus.Errorf("Bad request: %v", err)
+		return
+	case nil:
+
+	default:
+		w.WriteHeader(http.StatusInternalServerError)
+		logrus.Errorf("Error while get studios: %v", err)
+		return
+	}
+
+	studiosRaw, err := json.Marshal(studios)
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		logrus.Errorf("Error while marshal: %v", err)
+		return
+	}
+
+	w.Write(studiosRaw)
+}
+
+func (h *StudioHandler) GetStudio(w http.ResponseWriter, r *http.Request) {
+	studioID, err := strconv.ParseUint(mux.Vars(r)["id"], 10, 64)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		logrus.Error("Bad request: studio id")
+		return
+	}
+
+	studio, err := h.SUsecase.GetStudio(context.Background(), studioID)
+	switch err {
+	case domain.StudioNotFound:
+		w.WriteHeader(http.StatusNotFound)
+		logrus.Errorf("studio not found: %v", err)
+		return
+	case nil:
+
+	default:
+		w.WriteHeader(http.StatusInternalServerError)
+		logrus.Errorf("Error while get studio: %v", err)
+		return
+	}
+
+	studioRaw, err := json.Marshal(studio)
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		logrus.Errorf("Error while marshal: %v", err)
+		return
+	}
+
+	w.Write(studioRaw)
+}
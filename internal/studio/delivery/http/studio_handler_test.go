@@ -0,0 +1,100 @@
+package http
+
+import (
+	"Kinopoisk-Parser/internal/domain"
+	"context"
+	"encoding/json"
+	"errors"
+	"github.com/gorilla/mux"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+type fakeStudios struct {
+	domain.StudioUsecase
+	id         uint64
+	studioType string
+	err        error
+}
+
+func (f *fakeStudios) GetByMovie(ctx context.Context, movieID uint64, studioType string) ([]domain.Studio, error) {
+	f.id, f.studioType = movieID, studioType
+
+	return []domain.Studio{{ID: 1, Title: "Warner Bros.", Type: domain.ProductionStudio}}, f.err
+}
+
+func (f *fakeStudios) GetStudio(ctx context.Context, id uint64) (domain.Studio, error) {
+	f.id = id
+
+	return domain.Studio{ID: id, Title: "Warner Bros.", Movies: []uint64{301}}, f.err
+}
+
+func serve(usecase domain.StudioUsecase, target string) *httptest.ResponseRecorder {
+	handler := NewStudioHandler(usecase)
+
+	r := mux.NewRouter()
+	r.HandleFunc("/movies/{id:[0-9]+}/studios", handler.GetMovieStudios).Methods("GET")
+	r.HandleFunc("/studios/{id:[0-9]+}", handler.GetStudio).Methods("GET")
+
+	recorder := httptest.NewRecorder()
+	r.ServeHTTP(recorder, httptest.NewRequest("GET", target, nil))
+
+	return recorder
+}
+
+func TestGetMovieStudios(t *testing.T) {
+	usecase := &fakeStudios{}
+
+	recorder := serve(usecase, "/movies/301/studios?type=production")
+	if recorder.Code != http.StatusOK {
+		t.Fatalf("status = %d", recorder.Code)
+	}
+	if usecase.id != 301 || usecase.studioType != domain.ProductionStudio {
+		t.Errorf("id = %d, type = %q", usecase.id, usecase.studioType)
+	}
+
+	var studios []domain.Studio
+	if err := json.Unmarshal(recorder.Body.Bytes(), &studios); err != nil {
+		t.Fatalf("unmarshal: %v", err)
+	}
+	if len(studios) != 1 || studios[0].Title != "Warner Bros." {
+		t.Errorf("studios = %+v", studios)
+	}
+}
+
+func TestGetStudio(t *testing.T) {
+	usecase := &fakeStudios{}
+
+	recorder := serve(usecase, "/studios/1")
+	if recorder.Code != http.StatusOK {
+		t.Fatalf("status = %d", recorder.Code)
+	}
+
+	var studio domain.Studio
+	if err := json.Unmarshal(recorder.Body.Bytes(), &studio); err != nil {
+		t.Fatalf("unmarshal: %v", err)
+	}
+	if studio.ID != 1 || len(studio.Movies) != 1 || studio.Movies[0] != 301 {
+		t.Errorf("studio = %+v", studio)
+	}
+}
+
+func TestStudioErrors(t *testing.T) {
+	tests := []struct {
+		target string
+		err    error
+		code   int
+	}{
+		{"/movies/301/studios?type=catering", domain.UnknownStudioType, http.StatusBadRequest},
+		{"/movies/301/studios", errors.New("connection refused"), http.StatusInternalServerError},
+		{"/studios/1", domain.StudioNotFound, http.StatusNotFound},
+		{"/studios/1", errors.New("connection refused"), http.StatusInternalServerError},
+	}
+
+	for _, test := range tests {
+		if code := serve(&fakeStudios{err: test.err}, test.target).Code; code != test.code {
+			t.Errorf("%s, %v: status = %d, expected %d", test.target, test.err, code, test.code)
+		}
+	}
+}
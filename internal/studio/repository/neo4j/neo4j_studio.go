@@ -0,0 +1,151 @@
+package neo4jStudioRepo
+
+import (
+	"Kinopoisk-Parser/internal/domain"
+	"context"
+	"github.com/neo4j/neo4j-go-driver/v5/neo4j"
+	"github.com/sirupsen/logrus"
+)
+
+type Neo4jStudioRepo struct {
+	Driver neo4j.DriverWithContext
+}
+
+func New(driver neo4j.DriverWithContext) domain.StudioRepository {
+	return &Neo4jStudioRepo{Driver: driver}
+}
+
+func (n Neo4jStudioRepo) GetByMovie(ctx context.Context, movieID uint64, studioType string) ([]domain.Studio, error) {
+	result, err := neo4j.ExecuteQuery(ctx, n.Driver,
+		"MATCH (s:Studio)-[:WORKED_ON]->(m:Movie {ID: $id}) WHERE $type = '' OR s.Type = $type "+
+			"RETURN s ORDER BY s.Type, s.Title",
+		map[string]any{
+			"id":   movieID,
+			"type": studioType,
+		}, neo4j.EagerResultTransformer)
+	if err != nil {
+		logrus.Error(err)
+		return nil, err
+	}
+
+	resultStudios := make([]domain.Studio, 0)
+
+	for _, record := range result.Records {
+		itemNode, _, err := neo4j.GetRecordValue[neo4j.Node](record, "s")
+		if err != nil {
+			return nil, err
+		}
+
+		resultStudios = append(resultStudios, studioFromNode(itemNode))
+	}
+
+	return resultStudios, nil
+}
+
+func (n Neo4jStudioRepo) GetByID(ctx context.Context, id uint64) (domain.Studio, error) {
+	result, err := neo4j.ExecuteQuery(ctx, n.Driver,
+		"MATCH (s:Studio {ID: $id}) return s",
+		map[string]any{
+			"id": id,
+		}, neo4j.EagerResultTransformer)
+	if err != nil {
+		logrus.Error(err)
+		return domain.Studio{}, err
+	}
+
+	if len(result.Records) == 0 {
+		return domain.Studio{}, domain.StudioNotFound
+	}
+
+	itemNode, _, err := neo4j.GetRecordValue[neo4j.Node](result.Records[0], "s")
+	if err != nil {
+		return domain.Studio{}, domain.StudioNotFound
+	}
+
+	return studioFromNode(itemNode), nil
+}
+
+func studioFromNode(itemNode neo4j.Node) domain.Studio {
+	studio := domain.Studio{}
+
+	id, _ := neo4j.GetProperty[int64](itemNode, "ID")
+	studio.ID = uint64(id)
+
+	studio.Title, _ = neo4j.GetProperty[string](itemNode, "Title")
+	studio.Type, _ = neo4j.GetProperty[string](itemNode, "Type")
+	studio.SubType, _ = neo4j.GetProperty[string](itemNode, "SubType")
+
+	return studio
+}
+
+func (n Neo4jStudioRepo) GetMovies(ctx context.Context, studioID uint64) ([]uint64, error) {
+	result, err := neo4j.ExecuteQuery(ctx, n.Driver,
+		"MATCH (:Studio {ID: $id})-[:WORKED_ON]->(m:Movie) RETURN m.ID AS id ORDER BY id",
+		map[string]any{
+			"id": studioID,
+		}, neo4j.EagerResultTransformer)
+	if err != nil {
+		logrus.Error(err)
+		return nil, err
+	}
+
+	movies := make([]uint64, 0, len(result.Records))
+	for _, record := range result.Records {
+		id, _, err := neo4j.GetRecordValue[int64](record, "id")
+		if err != nil {
+			return nil, err
+		}
+
+		movies = append(movies, uint64(id))
+	}
+
+	return movies, nil
+}
+
+func (n Neo4jStudioRepo) Replace(ctx context.Context, movieID uint64, studios []domain.Studio) error {
+	props := make([]any, 0, len(studios))
+	for _, studio := range studios {
+		props = append(props, map[string]any{
+			"ID":      studio.ID,
+			"Title":   studio.Title,
+			"Type":    studio.Type,
+			"SubType": studio.SubType,
+		})
+	}
+
+	params := map[string]any{
+		"id":      movieID,
+		"studios": props,
+	}
+
+	session := n.Driver.NewSession(ctx, neo4j.SessionConfig{})
+	defer func() {
+		err := session.Close(ctx)
+		if err != nil {
+			logrus.Errorf("Repo closing error: %v", err)
+		}
+	}()
+
+	// Runs as one transaction, like the delete and insert in PostgreSQL.
+	_, err := session.ExecuteWrite(ctx, func(tx neo4j.ManagedTransaction) (any, error) {
+		result, err := tx.Run(ctx, "MATCH (:Studio)-[r:WORKED_ON]->(:Movie {ID: $id}) DELETE r", params)
+		if err != nil {
+			return nil, err
+		}
+		_, err = result.Consume(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		result, err = tx.Run(ctx,
+			"MATCH (m:Movie {ID: $id}) UNWIND $studios AS studio "+
+				"MERGE (s:Studio {ID: studio.ID}) SET s += studio MERGE (s)-[:WORKED_ON]->(m)", params)
+		if err != nil {
+			return nil, err
+		}
+
+		return result.Consume(ctx)
+	})
+
+	return err
+}
@@ -0,0 +1,165 @@
+package postgresql
+
+import (
+	"Kinopoisk-Parser/internal/domain"
+	"context"
+	"database/sql"
+	"github.com/sirupsen/logrus"
+)
+
+const studioColumns = `s.id, s.title, s.studio_type, s.sub_type`
+
+type pgStudioRepo struct {
+	Conn *sql.DB
+}
+
+func New(conn *sql.DB) domain.StudioRepository {
+	return &pgStudioRepo{Conn: conn}
+}
+
+// GetByMovie returns the studios of the given type, or all of them when the
+// type is empty.
+func (p pgStudioRepo) GetByMovie(ctx context.Context, movieID uint64, studioType string) ([]domain.Studio, error) {
+	query := `SELECT ` + studioColumns + ` FROM studios s JOIN movie_studios ms ON ms.studio_id = s.id
+			 WHERE ms.movie_id = $1 AND ($2 = '' OR s.studio_type = $2) ORDER BY s.studio_type, s.title;`
+
+	rows, err := p.Conn.QueryContext(ctx, query, movieID, studioType)
+	if err != nil {
+		logrus.Errorf("Repo error: %v", err)
+		return nil, err
+	}
+
+	defer func() {
+		err := rows.Close()
+		if err != nil {
+			logrus.Errorf("Repo closing error: %v", err)
+		}
+	}()
+
+	result := make([]domain.Studio, 0)
+	for rows.Next() {
+		tmpStudio, err := scanStudio(rows)
+		if err != nil {
+			logrus.Errorf("Repo error: %v", err)
+			return nil, err
+		}
+
+		result = append(result, tmpStudio)
+	}
+
+	return result, err
+}
+
+func (p pgStudioRepo) GetByID(ctx context.Context, id uint64) (domain.Studio, error) {
+	query := `SELECT ` + studioColumns + ` FROM studios s WHERE s.id = $1;`
+
+	rows, err := p.Conn.QueryContext(ctx, query, id)
+	if err != nil {
+		logrus.Errorf("Repo error: %v", err)
+		return domain.Studio{}, err
+	}
+
+	defer func() {
+		err := rows.Close()
+		if err != nil {
+			logrus.Errorf("Repo closing error: %v", err)
+		}
+	}()
+
+	studio := domain.Studio{}
+	if rows.Next() {
+		studio, err = scanStudio(rows)
+	} else {
+		err = domain.StudioNotFound
+	}
+
+	if err != nil && err != domain.StudioNotFound {
+		logrus.Errorf("Repo error: %v", err)
+		return domain.Studio{}, err
+	}
+
+	return studio, err
+}
+
+func scanStudio(rows *sql.Rows) (domain.Studio, error) {
+	studio := domain.Studio{}
+	err := rows.Scan(
+		&studio.ID,
+		&studio.Title,
+		&studio.Type,
+		&studio.SubType)
+
+	return studio, err
+}
+
+func (p pgStudioRepo) GetMovies(ctx context.Context, studioID uint64) ([]uint64, error) {
+	query := `SELECT movie_id FROM movie_studios WHERE studio_id = $1 ORDER BY movie_id;`
+
+	rows, err := p.Conn.QueryContext(ctx, query, studioID)
+	if err != nil {
+		logrus.Errorf("Repo error: %v", err)
+		return nil, err
+	}
+
+	defer func() {
+		err := rows.Close()
+		if err != nil {
+			logrus.Errorf("Repo closing error: %v", err)
+		}
+	}()
+
+	result := make([]uint64, 0)
+	for rows.Next() {
+		var movieID uint64
+		err = rows.Scan(&movieID)
+		if err != nil {
+			logrus.Errorf("Repo error: %v", err)
+			return nil, err
+		}
+
+		result = append(result, movieID)
+	}
+
+	return result, err
+}
+
+// Replace stores the studios and swaps the links of the movie for links to
+// them. Studios themselves are shared between movies and are never removed.
+func (p pgStudioRepo) Replace(ctx context.Context, movieID uint64, studios []domain.Studio) error {
+	tx, err := p.Conn.BeginTx(ctx, nil)
+	if err != nil {
+		logrus.Errorf("Repo error: %v", err)
+		return err
+	}
+
+	defer func() {
+		err := tx.Rollback()
+		if err != nil && err != sql.ErrTxDone {
+			logrus.Errorf("Repo rollback error: %v", err)
+		}
+	}()
+
+	_, err = tx.ExecContext(ctx, `DELETE FROM movie_studios WHERE movie_id = $1;`, movieID)
+	if err != nil {
+		return err
+	}
+
+	studioQuery := `INSERT into studios(id, title, studio_type, sub_type) VALUES ($1, $2, $3, $4)
+			 ON CONFLICT (id) DO UPDATE SET title = excluded.title, studio_type = excluded.studio_type,
+			 sub_type = excluded.sub_type;`
+	linkQuery := `INSERT into movie_studios(movie_id, studio_id) VALUES ($1, $2) ON CONFLICT DO NOTHING;`
+
+	for _, studio := range studios {
+		_, err = tx.ExecContext(ctx, studioQuery, studio.ID, studio.Title, studio.Type, studio.SubType)
+		if err != nil {
+			return err
+		}
+
+		_, err = tx.ExecContext(ctx, linkQuery, movieID, studio.ID)
+		if err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
@@ -0,0 +1,78 @@
+package usecase
+
+import (
+	"Kinopoisk-Parser/internal/domain"
+	"context"
+	"fmt"
+	"github.com/sirupsen/logrus"
+	"time"
+)
+
+type studioUsecase struct {
+	studioRepo     domain.StudioRepository
+	contextTimeout time.Duration
+}
+
+func NewStudioUsecase(s domain.StudioRepository, timeout time.Duration) domain.StudioUsecase {
+	return &studioUsecase{
+		studioRepo:     s,
+		contextTimeout: timeout,
+	}
+}
+
+func (u *studioUsecase) GetByMovie(ctx context.Context, movieID uint64, studioType string) ([]domain.Studio, error) {
+	switch studioType {
+	case "", domain.ProductionStudio, domain.DistributionStudio, domain.EffectsStudio, domain.DubbingStudio:
+	default:
+		return nil, domain.UnknownStudioType
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, u.contextTimeout)
+	defer cancel()
+
+	studios, err := u.studioRepo.GetByMovie(ctx, movieID, studioType)
+	if err != nil {
+		logrus.Errorf("Usecase: %v", err)
+		return nil, fmt.Errorf("usecase: %v", err)
+	}
+
+	return studios, nil
+}
+
+// GetStudio returns the studio with the movies it is linked to.
+func (u *studioUsecase) GetStudio(ctx context.Context, id uint64) (domain.Studio, error) {
+	ctx, cancel := context.WithTimeout(ctx, u.contextTimeout)
+	defer cancel()
+
+	studio, err := u.studioRepo.GetByID(ctx, id)
+	if err == domain.StudioNotFound {
+		return domain.Studio{}, err
+	}
+	if err != nil {
+		logrus.Errorf("Usecase: %v", err)
+		return domain.Studio{}, fmt.Errorf("usecase: %v", err)
+	}
+
+	studio.Movies, err = u.studioRepo.GetMovies(ctx, id)
+	if err != nil {
+		logrus.Errorf("Usecase: %v", err)
+		return domain.Studio{}, fmt.Errorf("usecase: %v", err)
+	}
+
+	return studio, nil
+}
+
+// Save replaces the studios linked to the movie, so a studio missing from a
+// refreshed payload is unlinked.
+func (u *studioUsecase) Save(ctx context.Context, movieID uint64, studios []domain.Studio) error {
+	ctx, cancel := context.WithTimeout(ctx, u.contextTimeout)
+	defer cancel()
+
+	err := u.studioRepo.Replace(ctx, movieID, studios)
+	if err != nil {
+		logrus.Errorf("Usecase: %v", err)
+		return fmt.Errorf("usecase: %v", err)
+	}
+
+	return nil
+}
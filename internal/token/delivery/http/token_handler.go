@@ -0,0 +1,35 @@
+package http
+
+import (
+	"Kinopoisk-Parser/internal/domain"
+	"context"
+	"encoding/json"
+	"github.com/sirupsen/logrus"
+	"net/http"
+)
+
+type TokenHandler struct {
+	Tokens domain.TokenPool
+}
+
+func NewTokenHandler(tokens domain.TokenPool) TokenHandler {
+	return TokenHandler{Tokens: tokens}
+}
+
+func (h *TokenHandler) GetUsage(w http.ResponseWriter, r *http.Request) {
+	usage, err := h.Tokens.GetUsage(context.Background())
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		logrus.Errorf("Error while get token usage: %v", err)
+		return
+	}
+
+	usageRaw, err := json.Marshal(usage)
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		logrus.Errorf("Error while marshal: %v", err)
+		return
+	}
+
+	w.Write(usageRaw)
+}
@@ -0,0 +1,51 @@
+package http
+
+import (
+	"Kinopoisk-Parser/internal/domain"
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+type fakeTokens struct {
+	domain.TokenPool
+	usage []domain.TokenUsage
+	err   error
+}
+
+func (f fakeTokens) GetUsage(ctx context.Context) ([]domain.TokenUsage, error) {
+	return f.usage, f.err
+}
+
+func TestGetUsage(t *testing.T) {
+	handler := NewTokenHandler(fakeTokens{usage: []domain.TokenUsage{{Token: "abcd****wxyz", Used: 3, Unlimited: true}}})
+
+	recorder := httptest.NewRecorder()
+	handler.GetUsage(recorder, httptest.NewRequest("GET", "/tokens", nil))
+
+	if recorder.Code != http.StatusOK {
+		t.Fatalf("status = %d", recorder.Code)
+	}
+
+	var usage []domain.TokenUsage
+	if err := json.Unmarshal(recorder.Body.Bytes(), &usage); err != nil {
+		t.Fatalf("unmarshal: %v", err)
+	}
+	if len(usage) != 1 || usage[0].Token != "abcd****wxyz" || usage[0].Used != 3 || !usage[0].Unlimited {
+		t.Errorf("usage = %+v", usage)
+	}
+}
+
+func TestGetUsageError(t *testing.T) {
+	handler := NewTokenHandler(fakeTokens{err: errors.New("state is unavailable")})
+
+	recorder := httptest.NewRecorder()
+	handler.GetUsage(recorder, httptest.NewRequest("GET", "/tokens", nil))
+
+	if recorder.Code != http.StatusInternalServerError {
+		t.Errorf("status = %d", recorder.Code)
+	}
+}
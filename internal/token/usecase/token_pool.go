@@ -0,0 +1,141 @@
+package usecase
+
+import (
+	"Kinopoisk-Parser/config"
+	"Kinopoisk-Parser/internal/domain"
+	"context"
+	"crypto/sha1"
+	"encoding/hex"
+	"fmt"
+	"github.com/sirupsen/logrus"
+	"sync"
+	"time"
+)
+
+type tokenPool struct {
+	tokens    []config.TokenParams
+	stateRepo domain.FrontierRepository
+	mutex     sync.Mutex
+	current   int
+}
+
+func NewTokenPool(tokens []config.TokenParams, s domain.FrontierRepository) domain.TokenPool {
+	return &tokenPool{
+		tokens:    tokens,
+		stateRepo: s,
+	}
+}
+
+func (t *tokenPool) Acquire(ctx context.Context) (string, error) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	for i := range t.tokens {
+		index := (t.current + i) % len(t.tokens)
+		token := t.tokens[index]
+
+		disabled, err := t.isDisabled(ctx, token.Key)
+		if err != nil {
+			return "", err
+		}
+		if disabled {
+			continue
+		}
+
+		key := stateKey(domain.TokenUsageKey, token.Key)
+		used, err := t.stateRepo.GetState(ctx, key)
+		if err != nil && err != domain.StateNotFound {
+			logrus.Errorf("Token pool error load usage: %v", err)
+			return "", err
+		}
+
+		if token.DailyQuota > 0 && used >= token.DailyQuota {
+			continue
+		}
+
+		_, err = t.stateRepo.IncState(ctx, key, 1)
+		if err != nil {
+			logrus.Errorf("Token pool error count usage: %v", err)
+			return "", err
+		}
+
+		if index != t.current {
+			logrus.Infof("Token pool switched to token %s", mask(token.Key))
+		}
+		t.current = index
+		return token.Key, nil
+	}
+
+	return "", domain.TokensExhausted
+}
+
+func (t *tokenPool) Exhaust(ctx context.Context, token string) error {
+	logrus.Warnf("Token pool disabled token %s until tomorrow", mask(token))
+
+	err := t.stateRepo.SetState(ctx, stateKey(domain.TokenDisabledKey, token), 1)
+	if err != nil {
+		logrus.Errorf("Token pool error disable token: %v", err)
+	}
+
+	return err
+}
+
+func (t *tokenPool) GetUsage(ctx context.Context) ([]domain.TokenUsage, error) {
+	result := make([]domain.TokenUsage, 0)
+	for _, token := range t.tokens {
+		used, err := t.stateRepo.GetState(ctx, stateKey(domain.TokenUsageKey, token.Key))
+		if err != nil && err != domain.StateNotFound {
+			return nil, err
+		}
+
+		disabled, err := t.isDisabled(ctx, token.Key)
+		if err != nil {
+			return nil, err
+		}
+
+		usage := domain.TokenUsage{
+			Token:      mask(token.Key),
+			DailyQuota: token.DailyQuota,
+			Used:       used,
+			Unlimited:  token.DailyQuota == 0,
+			Disabled:   disabled,
+		}
+
+		if token.DailyQuota > used && !disabled {
+			usage.Remaining = token.DailyQuota - used
+		}
+		if token.DailyQuota > 0 && usage.Used > token.DailyQuota {
+			usage.Used = token.DailyQuota
+		}
+
+		result = append(result, usage)
+	}
+
+	return result, nil
+}
+
+func (t *tokenPool) isDisabled(ctx context.Context, token string) (bool, error) {
+	_, err := t.stateRepo.GetState(ctx, stateKey(domain.TokenDisabledKey, token))
+	switch err {
+	case nil:
+		return true, nil
+	case domain.StateNotFound:
+		return false, nil
+	default:
+		logrus.Errorf("Token pool error load state: %v", err)
+		return false, err
+	}
+}
+
+func stateKey(prefix, token string) string {
+	hash := sha1.Sum([]byte(token))
+	return fmt.Sprintf("%s_%s_%s", prefix, hex.EncodeToString(hash[:4]), time.Now().Format("2006-01-02"))
+}
+
+func mask(token string) string {
+	if len(token) <= 8 {
+		return "****"
+	}
+
+	return token[:4] + "****" + token[len(token)-4:]
+}
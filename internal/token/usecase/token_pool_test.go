@@ -0,0 +1,132 @@
+package usecase
+
+import (
+	"Kinopoisk-Parser/config"
+	"Kinopoisk-Parser/internal/domain"
+	"context"
+	"testing"
+)
+
+type fakeState struct {
+	domain.FrontierRepository
+	state map[string]uint64
+}
+
+func (f *fakeState) GetState(ctx context.Context, key string) (uint64, error) {
+	value, ok := f.state[key]
+	if !ok {
+		return 0, domain.StateNotFound
+	}
+
+	return value, nil
+}
+
+func (f *fakeState) SetState(ctx context.Context, key string, value uint64) error {
+	f.state[key] = value
+
+	return nil
+}
+
+func (f *fakeState) IncState(ctx context.Context, key string, delta uint64) (uint64, error) {
+	f.state[key] += delta
+
+	return f.state[key], nil
+}
+
+func newTestPool(tokens ...config.TokenParams) (domain.TokenPool, *fakeState) {
+	state := &fakeState{state: map[string]uint64{}}
+
+	return NewTokenPool(tokens, state), state
+}
+
+func acquire(t *testing.T, pool domain.TokenPool) string {
+	t.Helper()
+
+	token, err := pool.Acquire(context.Background())
+	if err != nil {
+		t.Fatalf("acquire: %v", err)
+	}
+
+	return token
+}
+
+func TestAcquireRotatesOnQuota(t *testing.T) {
+	pool, _ := newTestPool(config.TokenParams{Key: "first", DailyQuota: 2}, config.TokenParams{Key: "second", DailyQuota: 1})
+
+	for i, expected := range []string{"first", "first", "second"} {
+		if token := acquire(t, pool); token != expected {
+			t.Errorf("request %d: token = %q, expected %q", i+1, token, expected)
+		}
+	}
+
+	if _, err := pool.Acquire(context.Background()); err != domain.TokensExhausted {
+		t.Errorf("err = %v, all quotas are used", err)
+	}
+}
+
+func TestAcquireExhaustedDoesNotCount(t *testing.T) {
+	pool, state := newTestPool(config.TokenParams{Key: "first", DailyQuota: 1})
+
+	acquire(t, pool)
+	for i := 0; i < 3; i++ {
+		_, _ = pool.Acquire(context.Background())
+	}
+
+	key := stateKey(domain.TokenUsageKey, "first")
+	if state.state[key] != 1 {
+		t.Errorf("usage = %d, refused requests are counted", state.state[key])
+	}
+}
+
+func TestAcquireSkipsDisabledToken(t *testing.T) {
+	pool, _ := newTestPool(config.TokenParams{Key: "first"}, config.TokenParams{Key: "second"})
+
+	if token := acquire(t, pool); token != "first" {
+		t.Fatalf("token = %q", token)
+	}
+
+	if err := pool.Exhaust(context.Background(), "first"); err != nil {
+		t.Fatalf("exhaust: %v", err)
+	}
+
+	if token := acquire(t, pool); token != "second" {
+		t.Errorf("token = %q, the disabled token is used", token)
+	}
+}
+
+func TestGetUsage(t *testing.T) {
+	pool, _ := newTestPool(config.TokenParams{Key: "limited-token", DailyQuota: 3}, config.TokenParams{Key: "unlimited-token"})
+
+	acquire(t, pool)
+	if err := pool.Exhaust(context.Background(), "limited-token"); err != nil {
+		t.Fatalf("exhaust: %v", err)
+	}
+	acquire(t, pool)
+
+	usage, err := pool.GetUsage(context.Background())
+	if err != nil {
+		t.Fatalf("get usage: %v", err)
+	}
+	if len(usage) != 2 {
+		t.Fatalf("usage = %+v", usage)
+	}
+
+	limited := usage[0]
+	if limited.Token != "limi****oken" || limited.Used != 1 || limited.Remaining != 0 || !limited.Disabled || limited.Unlimited {
+		t.Errorf("limited token usage = %+v", limited)
+	}
+
+	unlimited := usage[1]
+	if !unlimited.Unlimited || unlimited.Used != 1 || unlimited.Remaining != 0 || unlimited.Disabled {
+		t.Errorf("unlimited token usage = %+v", unlimited)
+	}
+}
+
+func TestMask(t *testing.T) {
+	if mask("short") != "****" {
+		t.Errorf("short token = %q", mask("short"))
+	}
+	if mask("0123456789") != "0123****6789" {
+		t.Errorf("token = %q", mask("0123456789"))
+	}
+}
@@ -0,0 +1,97 @@
+package neo4jVisitedRepo
+
+import (
+	"Kinopoisk-Parser/internal/domain"
+	"context"
+	"github.com/neo4j/neo4j-go-driver/v5/neo4j"
+	"github.com/sirupsen/logrus"
+)
+
+type Neo4jVisitedRepo struct {
+	Driver neo4j.DriverWithContext
+}
+
+func New(driver neo4j.DriverWithContext) domain.VisitedRepository {
+	return &Neo4jVisitedRepo{Driver: driver}
+}
+
+func (n Neo4jVisitedRepo) IsVisited(ctx context.Context, kind, id uint64) (bool, error) {
+	result, err := neo4j.ExecuteQuery(ctx, n.Driver,
+		"MATCH (v:Visited {Kind: $kind, ID: $id}) RETURN count(v) AS total",
+		map[string]any{
+			"kind": kind,
+			"id":   id,
+		}, neo4j.EagerResultTransformer)
+	if err != nil {
+		logrus.Error(err)
+		return false, err
+	}
+
+	total, _, err := neo4j.GetRecordValue[int64](result.Records[0], "total")
+	if err != nil {
+		return false, err
+	}
+
+	return total > 0, nil
+}
+
+func (n Neo4jVisitedRepo) Visit(ctx context.Context, kind, id uint64) (bool, error) {
+	result, err := neo4j.ExecuteQuery(ctx, n.Driver,
+		"MERGE (v:Visited {Kind: $kind, ID: $id}) "+
+			"ON CREATE SET v.Created = true ON MATCH SET v.Created = false "+
+			"RETURN v.Created AS created",
+		map[string]any{
+			"kind": kind,
+			"id":   id,
+		}, neo4j.EagerResultTransformer)
+	if err != nil {
+		logrus.Error(err)
+		return false, err
+	}
+
+	created, _, err := neo4j.GetRecordValue[bool](result.Records[0], "created")
+	if err != nil {
+		return false, err
+	}
+
+	return created, nil
+}
+
+func (n Neo4jVisitedRepo) Forget(ctx context.Context, kind, id uint64) error {
+	_, err := neo4j.ExecuteQuery(ctx, n.Driver,
+		"MATCH (v:Visited {Kind: $kind, ID: $id}) DELETE v",
+		map[string]any{
+			"kind": kind,
+			"id":   id,
+		}, neo4j.EagerResultTransformer)
+
+	return err
+}
+
+func (n Neo4jVisitedRepo) Reject(ctx context.Context, kind, id uint64) error {
+	_, err := neo4j.ExecuteQuery(ctx, n.Driver,
+		"MATCH (v:Visited {Kind: $kind, ID: $id}) SET v.Rejected = true",
+		map[string]any{
+			"kind": kind,
+			"id":   id,
+		}, neo4j.EagerResultTransformer)
+
+	return err
+}
+
+func (n Neo4jVisitedRepo) ForgetRejected(ctx context.Context) (uint64, error) {
+	result, err := neo4j.ExecuteQuery(ctx, n.Driver,
+		"MATCH (v:Visited) WHERE v.Rejected = true DELETE v RETURN count(v) AS total",
+		map[string]any{}, neo4j.EagerResultTransformer)
+	if err != nil {
+		logrus.Error(err)
+		return 0, err
+	}
+
+	total, _, err := neo4j.GetRecordValue[int64](result.Records[0], "total")
+	if err != nil {
+		return 0, err
+	}
+
+	return uint64(total), nil
+}
@@ -0,0 +1,81 @@
+package postgresql
+
+import (
+	"Kinopoisk-Parser/internal/domain"
+	"context"
+	"database/sql"
+	"github.com/sirupsen/logrus"
+)
+
+type pgVisitedRepo struct {
+	Conn *sql.DB
+}
+
+func New(conn *sql.DB) domain.VisitedRepository {
+	return &pgVisitedRepo{Conn: conn}
+}
+
+func (p pgVisitedRepo) IsVisited(ctx context.Context, kind, id uint64) (bool, error) {
+	query := `SELECT EXISTS(SELECT 1 FROM visited WHERE item_kind = $1 AND item_id = $2);`
+
+	var result bool
+	err := p.Conn.QueryRowContext(ctx, query, kind, id).Scan(&result)
+	if err != nil {
+		logrus.Errorf("Repo error: %v", err)
+		return false, err
+	}
+
+	return result, nil
+}
+
+func (p pgVisitedRepo) Visit(ctx context.Context, kind, id uint64) (bool, error) {
+	query := `INSERT into visited(item_kind, item_id) VALUES ($1, $2) ON CONFLICT DO NOTHING;`
+
+	res, err := p.Conn.ExecContext(ctx, query, kind, id)
+	if err != nil {
+		logrus.Errorf("Repo error: %v", err)
+		return false, err
+	}
+
+	inserted, err := res.RowsAffected()
+	if err != nil {
+		logrus.Errorf("Repo error: %v", err)
+		return false, err
+	}
+
+	return inserted == 1, nil
+}
+
+func (p pgVisitedRepo) Forget(ctx context.Context, kind, id uint64) error {
+	query := `DELETE FROM visited WHERE item_kind = $1 AND item_id = $2;`
+
+	_, err := p.Conn.ExecContext(ctx, query, kind, id)
+
+	return err
+}
+
+func (p pgVisitedRepo) Reject(ctx context.Context, kind, id uint64) error {
+	query := `UPDATE visited SET rejected = true WHERE item_kind = $1 AND item_id = $2;`
+
+	_, err := p.Conn.ExecContext(ctx, query, kind, id)
+
+	return err
+}
+
+func (p pgVisitedRepo) ForgetRejected(ctx context.Context) (uint64, error) {
+	query := `DELETE FROM visited WHERE rejected;`
+
+	res, err := p.Conn.ExecContext(ctx, query)
+	if err != nil {
+		logrus.Errorf("Repo error: %v", err)
+		return 0, err
+	}
+
+	deleted, err := res.RowsAffected()
+	if err != nil {
+		logrus.Errorf("Repo error: %v", err)
+		return 0, err
+	}
+
+	return uint64(deleted), nil
+}